@@ -222,6 +222,37 @@ func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
 	return nil
 }
 
+// vcrRedactionHook is a before save hook to rewrite sensitive values in recorded
+// interactions to stable placeholders using the rules registered in the vcr package.
+//
+// Redaction happens only when the cassette is saved so that the provider sees the
+// real values while recording.
+func vcrRedactionHook(i *cassette.Interaction) error {
+	i.Request.URL = vcr.Redact(i.Request.URL)
+	i.Request.Body = vcr.Redact(i.Request.Body)
+	for k, v := range i.Request.Form {
+		for j := range v {
+			v[j] = vcr.Redact(v[j])
+		}
+		i.Request.Form[k] = v
+	}
+
+	if body := vcr.Redact(i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+		// The recorded length and checksums no longer describe the redacted body.
+		i.Response.ContentLength = int64(len(body))
+		i.Response.Headers.Del("Content-Length")
+		i.Response.Headers.Del("X-Amz-Crc32")
+	}
+	for _, v := range i.Response.Headers {
+		for j := range v {
+			v[j] = vcr.Redact(v[j])
+		}
+	}
+
+	return nil
+}
+
 // vcrMatcherFunc defines how VCR will match requests to stored interactions.
 func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 	return func(r *http.Request, i cassette.Request) bool {
@@ -229,7 +260,7 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 			return false
 		}
 
-		if r.URL.String() != i.URL {
+		if url := r.URL.String(); url != i.URL && vcr.Redact(url) != i.URL {
 			return false
 		}
 
@@ -252,6 +283,12 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 			return true
 		}
 
+		// Recorded bodies may have been redacted.
+		body = vcr.Redact(body)
+		if body == i.Body {
+			return true
+		}
+
 		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
 		switch contentType := r.Header.Get("Content-Type"); contentType {
 		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
@@ -284,7 +321,7 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 }
 
 func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
-	vcrMode, err := vcr.Mode()
+	vcrMode, err := vcrTestMode(testName)
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +341,7 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 	// Create a VCR recorder around a default HTTP client.
 	r, err := recorder.New(cassetteName,
 		recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
+		recorder.WithHook(vcrRedactionHook, recorder.BeforeSaveHook),
 		recorder.WithMatcher(vcrMatcherFunc(ctx)),
 		recorder.WithMode(vcrMode),
 		recorder.WithRealTransport(httpClient.Transport),
@@ -317,11 +355,21 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 	return httpClient, nil
 }
 
+// vcrTestMode returns the VCR mode for a single test
+//
+// In RECORD_IF_MISSING mode, a test is replayed if both its cassette and seed
+// files exist, and is recorded otherwise.
+func vcrTestMode(testName string) (recorder.Mode, error) {
+	return vcr.ResolveMode(vcrCassetteFile(vcr.Path(), testName), vcrSeedFile(vcr.Path(), testName))
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing
 //
 // In RECORD_ONLY mode, generates a new seed to use as a source. This seed is
 // saved to a file when the recorder is closed.
 // In REPLAY_ONLY mode, reads a seed from a file and creates a source from it.
+// In RECORD_IF_MISSING mode, behaves as REPLAY_ONLY if the test has been
+// recorded and as RECORD_ONLY otherwise.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
 	testName := t.Name()
@@ -334,7 +382,7 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 		return s, nil
 	}
 
-	vcrMode, err := vcr.Mode()
+	vcrMode, err := vcrTestMode(testName)
 	if err != nil {
 		return nil, err
 	}
@@ -548,6 +596,10 @@ func vcrFileName(name string) string {
 	return strings.ReplaceAll(name, "/", "_")
 }
 
+func vcrCassetteFile(path, name string) string {
+	return filepath.Join(path, fmt.Sprintf("%s.yaml", vcrFileName(name)))
+}
+
 func vcrSeedFile(path, name string) string {
 	return filepath.Join(path, fmt.Sprintf("%s.seed", vcrFileName(name)))
 }
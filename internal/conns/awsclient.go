@@ -39,7 +39,8 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	randomnessSource          rand.Source                // For VCR deterministic randomness.
+	serviceLimiters           map[string]*serviceLimiter // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -78,12 +79,24 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
-	return c.defaultTagsConfig
+// DefaultTagsConfig returns the default tags configuration, with any scoped rules
+// evaluated for the resource type in the context.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(inContext.ServicePackageName(), inContext.TypeName())
+	}
+
+	return c.defaultTagsConfig.ForResource("", "")
 }
 
-func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
-	return c.ignoreTagsConfig
+// IgnoreTagsConfig returns the ignore tags configuration, with any scoped rules
+// evaluated for the resource type in the context.
+func (c *AWSClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.ignoreTagsConfig.ForResource(inContext.TypeName())
+	}
+
+	return c.ignoreTagsConfig.ForResource("")
 }
 
 func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
@@ -359,6 +372,11 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if l, ok := c.serviceLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(cfg.APIOptions, l.apiOption(servicePackageName))
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -29,6 +30,7 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	APIOptions                     []func(*middleware.Stack) error // Additional AWS API client middleware.
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
@@ -52,6 +54,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceLimits                  map[string]ServiceLimit // Service package name -> limit.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -60,6 +63,7 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TagPolicyConfig                *tftags.TagPolicyConfig
+	TagPolicyFile                  string
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -212,7 +216,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	if c.TagPolicyConfig != nil && c.TagPolicyFile != "" {
+		tflog.Debug(ctx, "Reading tag policy details", map[string]any{
+			"tag_policy_file": c.TagPolicyFile,
+		})
+		reqTags, enforcedTags, err := tagpolicy.GetPolicyFromFile(ctx, c.TagPolicyFile)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Reading Tag Policy File",
+				fmt.Sprintf("Failed to read the tag policy document from %q.\n\nOriginal error: %s", c.TagPolicyFile, err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.RequiredTags = reqTags
+		c.TagPolicyConfig.EnforcedTags = enforcedTags
+	} else if c.TagPolicyConfig != nil {
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -232,6 +249,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
@@ -240,6 +259,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3OriginalRegion = c.S3OriginalRegion
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	if len(c.ServiceLimits) > 0 {
+		client.serviceLimiters = make(map[string]*serviceLimiter, len(c.ServiceLimits))
+		for servicePackageName, limit := range c.ServiceLimits {
+			client.serviceLimiters[servicePackageName] = newServiceLimiter(limit)
+		}
+	}
 	client.stsRegion = c.STSRegion
 
 	return client, diags
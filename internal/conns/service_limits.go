@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ServiceLimit limits the API requests made by a single service's client.
+// A zero value for either field means no limit.
+type ServiceLimit struct {
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+}
+
+// serviceLimiter enforces a ServiceLimit across all clients (in all Regions) for a service.
+type serviceLimiter struct {
+	slots chan struct{} // nil if concurrency is unlimited.
+
+	mu       sync.Mutex
+	interval time.Duration // 0 if request rate is unlimited.
+	next     time.Time
+}
+
+func newServiceLimiter(limit ServiceLimit) *serviceLimiter {
+	l := &serviceLimiter{}
+
+	if v := limit.MaxConcurrentRequests; v > 0 {
+		l.slots = make(chan struct{}, v)
+	}
+	if v := limit.RequestsPerSecond; v > 0 {
+		l.interval = time.Duration(float64(time.Second) / v)
+	}
+
+	return l
+}
+
+// acquire blocks until a request may be sent or the context is done.
+// On success the returned function must be called once the request has completed.
+func (l *serviceLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// wait blocks until the next request is allowed by the configured request rate.
+func (l *serviceLimiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that adds the limiter to a client's middleware stack.
+// The limiter is added after the retry middleware so that each attempt is limited and
+// no slot is held while a request is backing off.
+func (l *serviceLimiter) apiOption(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(
+			middleware.FinalizeMiddlewareFunc(
+				"ServiceLimit",
+				func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+					release, err := l.acquire(ctx)
+					if err != nil {
+						return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for %s service limit: %w", servicePackageName, err)
+					}
+					defer release()
+
+					return next.HandleFinalize(ctx, in)
+				},
+			),
+			middleware.After,
+		)
+	}
+}
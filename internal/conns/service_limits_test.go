@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServiceLimiter_maxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := newServiceLimiter(ServiceLimit{MaxConcurrentRequests: 2})
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			release, err := l.acquire(ctx)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer release()
+
+			n := inFlight.Add(1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if got, want := maxInFlight.Load(), int32(2); got > want {
+		t.Errorf("max in-flight requests = %d, want at most %d", got, want)
+	}
+}
+
+func TestServiceLimiter_requestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := newServiceLimiter(ServiceLimit{RequestsPerSecond: 50})
+
+	start := time.Now()
+	for range 6 {
+		release, err := l.acquire(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		release()
+	}
+
+	// The first request is sent immediately, the next 5 are spaced 20ms apart.
+	if got, want := time.Since(start), 100*time.Millisecond; got < want {
+		t.Errorf("elapsed = %s, want at least %s", got, want)
+	}
+}
+
+func TestServiceLimiter_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceLimiter(ServiceLimit{MaxConcurrentRequests: 1})
+
+	release, err := l.acquire(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestServiceLimiter_unlimited(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceLimiter(ServiceLimit{})
+
+	for range 100 {
+		release, err := l.acquire(t.Context())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		release()
+	}
+}
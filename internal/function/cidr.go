@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// parseCIDRBlock parses the specified CIDR block, which must be the CIDR block for its network.
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := inttypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
+
+// parseCIDRBlocks parses the specified CIDR blocks, which must all be of the same address family.
+func parseCIDRBlocks(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := parseCIDRBlock(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(prefixes) > 0 && prefix.Addr().Is4() != prefixes[0].Addr().Is4() {
+			return nil, fmt.Errorf("%q and %q are not of the same address family", prefixes[0], prefix)
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
+}
+
+// prefixStrings returns the string representation of each prefix.
+func prefixStrings(prefixes []netip.Prefix) []string {
+	result := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		result[i] = prefix.String()
+	}
+
+	return result
+}
+
+// addrToInt returns the integer value of the specified address.
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+// intToAddr returns the address of the specified family with the specified integer value.
+func intToAddr(i *big.Int, is4 bool) netip.Addr {
+	if is4 {
+		var b [4]byte
+		i.FillBytes(b[:])
+		return netip.AddrFrom4(b)
+	}
+
+	var b [16]byte
+	i.FillBytes(b[:])
+	return netip.AddrFrom16(b)
+}
+
+// prefixSize returns the number of addresses in the specified prefix.
+func prefixSize(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// lastAddr returns the last address in the specified prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	last := addrToInt(prefix.Addr())
+	last.Add(last, prefixSize(prefix))
+	last.Sub(last, big.NewInt(1))
+
+	return intToAddr(last, prefix.Addr().Is4())
+}
+
+// sortPrefixes sorts prefixes by address and then by prefix length.
+func sortPrefixes(prefixes []netip.Prefix) {
+	slices.SortFunc(prefixes, func(a, b netip.Prefix) int {
+		if v := a.Addr().Compare(b.Addr()); v != 0 {
+			return v
+		}
+		return cmp.Compare(a.Bits(), b.Bits())
+	})
+}
+
+// collapsePrefixes returns the smallest set of prefixes covering exactly the same addresses as the specified prefixes.
+func collapsePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	result := slices.Clone(prefixes)
+
+	for changed := true; changed; {
+		changed = false
+		sortPrefixes(result)
+
+		collapsed := make([]netip.Prefix, 0, len(result))
+		for _, prefix := range result {
+			if n := len(collapsed); n > 0 {
+				previous := collapsed[n-1]
+
+				// Contained in the previous prefix.
+				if previous.Bits() <= prefix.Bits() && previous.Contains(prefix.Addr()) {
+					changed = true
+					continue
+				}
+
+				// Sibling of the previous prefix.
+				if previous.Bits() == prefix.Bits() && previous.Bits() > 0 {
+					if parent, _ := previous.Addr().Prefix(previous.Bits() - 1); parent.Contains(prefix.Addr()) {
+						collapsed[n-1] = parent
+						changed = true
+						continue
+					}
+				}
+			}
+
+			collapsed = append(collapsed, prefix)
+		}
+		result = collapsed
+	}
+
+	return result
+}
+
+// supernet returns the smallest prefix containing both of the specified prefixes.
+func supernet(a, b netip.Prefix) netip.Prefix {
+	bits := min(a.Bits(), b.Bits())
+	for ; bits > 0; bits-- {
+		if p, _ := a.Addr().Prefix(bits); p.Contains(b.Addr()) {
+			return p
+		}
+	}
+
+	p, _ := a.Addr().Prefix(0)
+	return p
+}
+
+// aggregatePrefixes returns at most maxEntries prefixes covering all the addresses in the specified prefixes.
+// If the prefixes cannot be collapsed into maxEntries prefixes, adjacent prefixes are replaced by the
+// supernet adding the fewest additional addresses until the result fits.
+func aggregatePrefixes(prefixes []netip.Prefix, maxEntries int) []netip.Prefix {
+	result := collapsePrefixes(prefixes)
+
+	for len(result) > maxEntries {
+		var best int
+		var bestCost *big.Int
+		for i := range len(result) - 1 {
+			cost := prefixSize(supernet(result[i], result[i+1]))
+			cost.Sub(cost, prefixSize(result[i]))
+			cost.Sub(cost, prefixSize(result[i+1]))
+
+			if bestCost == nil || cost.Cmp(bestCost) < 0 {
+				best, bestCost = i, cost
+			}
+		}
+
+		result[best] = supernet(result[best], result[best+1])
+		result = collapsePrefixes(slices.Delete(result, best+1, best+2))
+	}
+
+	return result
+}
+
+// overlappingPrefixes returns the first pair of overlapping prefixes, if any.
+func overlappingPrefixes(prefixes []netip.Prefix) (netip.Prefix, netip.Prefix, bool) {
+	for i, a := range prefixes {
+		for _, b := range prefixes[i+1:] {
+			if a.Overlaps(b) {
+				return a, b, true
+			}
+		}
+	}
+
+	return netip.Prefix{}, netip.Prefix{}, false
+}
+
+// reservedAddresses returns the addresses AWS reserves in a subnet with the specified CIDR block:
+// the network address, the VPC router, the DNS server, an address reserved for future use and the last address.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+func reservedAddresses(prefix netip.Prefix) []netip.Addr {
+	addr := prefix.Addr()
+	result := make([]netip.Addr, 0, 5)
+	for range 4 {
+		result = append(result, addr)
+		addr = addr.Next()
+	}
+
+	return append(result, lastAddr(prefix))
+}
+
+// subnetsByAZ allocates one subnet of each of the specified prefix lengths per Availability Zone from
+// the specified CIDR block. Subnets are allocated from the start of the CIDR block, largest first, so
+// that no address space is lost to alignment. The result maps each Availability Zone to its subnets,
+// in the order of the prefix lengths.
+func subnetsByAZ(prefix netip.Prefix, availabilityZones []string, prefixLengths []int) (map[string][]netip.Prefix, error) {
+	if len(availabilityZones) == 0 {
+		return nil, fmt.Errorf("at least one Availability Zone must be specified")
+	}
+	if len(slices.Compact(slices.Sorted(slices.Values(availabilityZones)))) != len(availabilityZones) {
+		return nil, fmt.Errorf("duplicate Availability Zones specified")
+	}
+
+	bitLen := prefix.Addr().BitLen()
+	for _, prefixLength := range prefixLengths {
+		if prefixLength < prefix.Bits() || prefixLength > bitLen {
+			return nil, fmt.Errorf("prefix length %d must be between %d and %d", prefixLength, prefix.Bits(), bitLen)
+		}
+	}
+
+	// Allocate the largest subnets first.
+	tiers := make([]int, len(prefixLengths))
+	for i := range tiers {
+		tiers[i] = i
+	}
+	slices.SortStableFunc(tiers, func(a, b int) int {
+		return cmp.Compare(prefixLengths[a], prefixLengths[b])
+	})
+
+	result := make(map[string][]netip.Prefix, len(availabilityZones))
+	for _, az := range availabilityZones {
+		result[az] = make([]netip.Prefix, len(prefixLengths))
+	}
+
+	next := addrToInt(prefix.Addr())
+	end := addrToInt(prefix.Addr())
+	end.Add(end, prefixSize(prefix))
+	for _, tier := range tiers {
+		for _, az := range availabilityZones {
+			// Check the subnet fits before converting its address, as the address following
+			// the last subnet may lie beyond the end of the address space.
+			subnetEnd := prefixSize(netip.PrefixFrom(prefix.Addr(), prefixLengths[tier]))
+			subnetEnd.Add(subnetEnd, next)
+			if subnetEnd.Cmp(end) > 0 {
+				return nil, fmt.Errorf("%s is too small for the requested subnets", prefix)
+			}
+
+			result[az][tier] = netip.PrefixFrom(intToAddr(next, prefix.Addr().Is4()), prefixLengths[tier])
+			next = subnetEnd
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrAggregateFunction{}
+
+func NewCIDRAggregateFunction() function.Function {
+	return &cidrAggregateFunction{}
+}
+
+type cidrAggregateFunction struct{}
+
+func (f cidrAggregateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_aggregate"
+}
+
+func (f cidrAggregateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_aggregate Function",
+		MarkdownDescription: "Aggregates a list of CIDR blocks into at most a maximum number of entries, e.g. to fit a prefix list",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidrs",
+				MarkdownDescription: "CIDR blocks to aggregate",
+				ElementType:         types.StringType,
+			},
+			function.Int64Parameter{
+				Name:                "max_entries",
+				MarkdownDescription: "Maximum number of CIDR blocks to return",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrAggregateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+	var maxEntries int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs, &maxEntries))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes, err := parseCIDRBlocks(cidrs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if maxEntries < 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "max_entries must be at least 1"))
+		return
+	}
+
+	result := prefixStrings(aggregatePrefixes(prefixes, int(maxEntries)))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
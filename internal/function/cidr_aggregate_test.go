@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testCIDRAggregateFunctionCIDRs = `["10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24", "10.0.3.0/24", "10.0.8.0/24", "192.168.0.0/24"]`
+
+func TestCIDRAggregateFunction_exact(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRAggregateFunctionConfig(testCIDRAggregateFunctionCIDRs, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/22,10.0.8.0/24,192.168.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRAggregateFunction_maxEntries(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRAggregateFunctionConfig(testCIDRAggregateFunctionCIDRs, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/20,192.168.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRAggregateFunction_mixedAddressFamilies(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRAggregateFunctionConfig(`["10.0.0.0/24", "2001:db8::/56"]`, 2),
+				ExpectError: regexache.MustCompile(`not[\s\n]*of[\s\n]*the[\s\n]*same[\s\n]*address[\s\n]*family`),
+			},
+		},
+	})
+}
+
+func testCIDRAggregateFunctionConfig(cidrs string, maxEntries int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_aggregate(%[1]s, %[2]d))
+}
+`, cidrs, maxEntries)
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrReservedAddressesFunction{}
+
+func NewCIDRReservedAddressesFunction() function.Function {
+	return &cidrReservedAddressesFunction{}
+}
+
+type cidrReservedAddressesFunction struct{}
+
+func (f cidrReservedAddressesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_reserved_addresses"
+}
+
+func (f cidrReservedAddressesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_reserved_addresses Function",
+		MarkdownDescription: "Returns the IP addresses AWS reserves in a subnet",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "CIDR block of the subnet",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrReservedAddressesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	// See https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+	minBits, maxBits := 16, 28
+	if prefix.Addr().Is6() {
+		minBits, maxBits = 44, 64
+	}
+	if bits := prefix.Bits(); bits < minBits || bits > maxBits {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("subnet prefix length must be between %d and %d, got %d", minBits, maxBits, bits)))
+		return
+	}
+
+	var result []string
+	for _, addr := range reservedAddresses(prefix) {
+		result = append(result, addr.String())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
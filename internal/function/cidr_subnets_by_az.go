@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSubnetsByAZFunction{}
+
+func NewCIDRSubnetsByAZFunction() function.Function {
+	return &cidrSubnetsByAZFunction{}
+}
+
+type cidrSubnetsByAZFunction struct{}
+
+func (f cidrSubnetsByAZFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_by_az"
+}
+
+func (f cidrSubnetsByAZFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_subnets_by_az Function",
+		MarkdownDescription: "Splits a CIDR block into subnets balanced across Availability Zones",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "CIDR block to split, e.g. a VPC CIDR block",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones to allocate subnets in",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				MarkdownDescription: "Prefix length of each subnet to allocate in every Availability Zone",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ListType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (f cidrSubnetsByAZFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var availabilityZones []string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &availabilityZones, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	lengths := make([]int, len(prefixLengths))
+	for i, v := range prefixLengths {
+		lengths[i] = int(v)
+	}
+
+	subnets, err := subnetsByAZ(prefix, availabilityZones, lengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result := make(map[string][]string, len(subnets))
+	for az, v := range subnets {
+		result[az] = prefixStrings(v)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsByAZFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, "[24, 20]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.48.0/24,10.0.0.0/20;10.0.49.0/24,10.0.16.0/20;10.0.50.0/24,10.0.32.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig("2001:db8::/56", `["us-west-2a", "us-west-2b", "us-west-2c"]`, "[64]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2001:db8::/64;2001:db8:0:1::/64;2001:db8:0:2::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.0/24", `["us-west-2a", "us-west-2b", "us-west-2c"]`, "[25]"),
+				ExpectError: regexache.MustCompile(`too[\s\n]*small`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_invalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.1/16", `["us-west-2a"]`, "[24]"),
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsByAZFunctionConfig(cidr, availabilityZones, prefixLengths string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_by_az(%[1]q, %[2]s, %[3]s)
+}
+
+output "test" {
+  value = join(";", [for az in sort(keys(local.subnets)) : join(",", local.subnets[az])])
+}
+`, cidr, availabilityZones, prefixLengths)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSubnetsByAZ(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidr              string
+		availabilityZones []string
+		prefixLengths     []int
+		expected          map[string][]string
+		expectedErr       bool
+	}{
+		"exact fit": {
+			cidr:              "10.0.0.0/24",
+			availabilityZones: []string{"a", "b"},
+			prefixLengths:     []int{26, 26},
+			expected: map[string][]string{
+				"a": {"10.0.0.0/26", "10.0.0.128/26"},
+				"b": {"10.0.0.64/26", "10.0.0.192/26"},
+			},
+		},
+		"too small": {
+			cidr:              "10.0.0.0/24",
+			availabilityZones: []string{"a", "b", "c"},
+			prefixLengths:     []int{25},
+			expectedErr:       true,
+		},
+		"top of IPv4 space exact fit": {
+			cidr:              "255.255.255.0/24",
+			availabilityZones: []string{"a", "b"},
+			prefixLengths:     []int{25},
+			expected: map[string][]string{
+				"a": {"255.255.255.0/25"},
+				"b": {"255.255.255.128/25"},
+			},
+		},
+		"top of IPv4 space too small": {
+			cidr:              "255.255.255.0/24",
+			availabilityZones: []string{"a", "b", "c"},
+			prefixLengths:     []int{25},
+			expectedErr:       true,
+		},
+		"top of IPv6 space exact fit": {
+			cidr:              "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/120",
+			availabilityZones: []string{"a", "b"},
+			prefixLengths:     []int{121},
+			expected: map[string][]string{
+				"a": {"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/121"},
+				"b": {"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff80/121"},
+			},
+		},
+		"top of IPv6 space too small": {
+			cidr:              "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/120",
+			availabilityZones: []string{"a", "b", "c"},
+			prefixLengths:     []int{121},
+			expectedErr:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := subnetsByAZ(netip.MustParsePrefix(testCase.cidr), testCase.availabilityZones, testCase.prefixLengths)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			gotStrings := make(map[string][]string, len(got))
+			for az, prefixes := range got {
+				gotStrings[az] = prefixStrings(prefixes)
+			}
+
+			if diff := cmp.Diff(gotStrings, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrsOverlapFunction{}
+
+func NewCIDRsOverlapFunction() function.Function {
+	return &cidrsOverlapFunction{}
+}
+
+type cidrsOverlapFunction struct{}
+
+func (f cidrsOverlapFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidrs_overlap"
+}
+
+func (f cidrsOverlapFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidrs_overlap Function",
+		MarkdownDescription: "Checks whether any of a list of CIDR blocks overlap",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidrs",
+				MarkdownDescription: "CIDR blocks to check",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrsOverlapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	// IPv4 and IPv6 CIDR blocks never overlap, so mixed address families are allowed.
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := parseCIDRBlock(cidr)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	_, _, result := overlappingPrefixes(prefixes)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	// iamPolicyDefaultMaxLength is the maximum length of an IAM managed policy document, in characters, excluding whitespace.
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+	iamPolicyDefaultMaxLength = 6144
+)
+
+type iamPolicyDocument struct {
+	Version   string                `json:",omitempty"`
+	Id        string                `json:",omitempty"`
+	Statement iamPolicyStatementSet `json:",omitempty"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string `json:",omitempty"`
+	Effect       string `json:",omitempty"`
+	Principal    any    `json:",omitempty"`
+	NotPrincipal any    `json:",omitempty"`
+	Action       any    `json:",omitempty"`
+	NotAction    any    `json:",omitempty"`
+	Resource     any    `json:",omitempty"`
+	NotResource  any    `json:",omitempty"`
+	Condition    any    `json:",omitempty"`
+}
+
+// iamPolicyStatementSet is a list of statements. A policy document may contain a single statement object.
+type iamPolicyStatementSet []*iamPolicyStatement
+
+func (ss *iamPolicyStatementSet) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		var s iamPolicyStatement
+		if err := decodeIAMPolicyJSON(b, &s); err != nil {
+			return err
+		}
+
+		*ss = iamPolicyStatementSet{&s}
+		return nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	result := make(iamPolicyStatementSet, 0, len(raw))
+	for _, v := range raw {
+		var s iamPolicyStatement
+		if err := decodeIAMPolicyJSON(v, &s); err != nil {
+			return err
+		}
+
+		result = append(result, &s)
+	}
+
+	*ss = result
+	return nil
+}
+
+// decodeIAMPolicyJSON decodes JSON, rejecting unknown policy elements and preserving numbers as written.
+func decodeIAMPolicyJSON(b []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// parseIAMPolicyDocument parses and normalizes the specified IAM policy document.
+func parseIAMPolicyDocument(policy string) (*iamPolicyDocument, error) {
+	var doc iamPolicyDocument
+	if err := decodeIAMPolicyJSON([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("parsing IAM policy document: %w", err)
+	}
+
+	for i, s := range doc.Statement {
+		if s.Effect != "Allow" && s.Effect != "Deny" {
+			return nil, fmt.Errorf("statement %d: Effect must be \"Allow\" or \"Deny\", got %q", i, s.Effect)
+		}
+	}
+
+	doc.normalize()
+
+	return &doc, nil
+}
+
+// merge merges the specified document into the document.
+// Statements with the same Sid as an existing statement replace that statement.
+func (d *iamPolicyDocument) merge(other *iamPolicyDocument) {
+	if other.Id != "" {
+		d.Id = other.Id
+	}
+
+	if other.Version > d.Version {
+		d.Version = other.Version
+	}
+
+	for _, s := range other.Statement {
+		if i := slices.IndexFunc(d.Statement, func(v *iamPolicyStatement) bool {
+			return s.Sid != "" && v.Sid == s.Sid
+		}); i >= 0 {
+			d.Statement[i] = s
+			continue
+		}
+
+		d.Statement = append(d.Statement, s)
+	}
+}
+
+// normalize deduplicates and sorts the values of each statement element
+// and removes duplicate statements.
+func (d *iamPolicyDocument) normalize() {
+	seen := make(map[string]bool, len(d.Statement))
+	statements := make(iamPolicyStatementSet, 0, len(d.Statement))
+	for _, s := range d.Statement {
+		s.Principal = normalizeIAMPolicyValue(s.Principal)
+		s.NotPrincipal = normalizeIAMPolicyValue(s.NotPrincipal)
+		s.Action = normalizeIAMPolicyValue(s.Action)
+		s.NotAction = normalizeIAMPolicyValue(s.NotAction)
+		s.Resource = normalizeIAMPolicyValue(s.Resource)
+		s.NotResource = normalizeIAMPolicyValue(s.NotResource)
+		s.Condition = normalizeIAMPolicyValue(s.Condition)
+
+		key := iamPolicyValueKey(s)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		statements = append(statements, s)
+	}
+	d.Statement = statements
+}
+
+// marshal returns the compact JSON representation of the document.
+// An error is returned if the document, excluding whitespace, is longer than maxLength characters.
+func (d *iamPolicyDocument) marshal(maxLength int) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(d); err != nil {
+		return "", err
+	}
+
+	result := strings.TrimSuffix(buf.String(), "\n")
+
+	var length int
+	for _, r := range result {
+		if !unicode.IsSpace(r) {
+			length++
+		}
+	}
+	if length > maxLength {
+		return "", fmt.Errorf("IAM policy document is %d characters long, excluding whitespace, which exceeds the maximum of %d characters", length, maxLength)
+	}
+
+	return result, nil
+}
+
+// normalizeIAMPolicyValue returns the specified policy element value with lists deduplicated and sorted.
+// Single element lists are replaced by their element, as AWS does, and empty values by nil.
+func normalizeIAMPolicyValue(v any) any {
+	switch v := v.(type) {
+	case []any:
+		seen := make(map[string]any, len(v))
+		for _, e := range v {
+			e = normalizeIAMPolicyValue(e)
+			seen[iamPolicyValueKey(e)] = e
+		}
+
+		switch len(seen) {
+		case 0:
+			return nil
+		case 1:
+			for _, e := range seen {
+				return e
+			}
+		}
+
+		result := make([]any, 0, len(seen))
+		for _, k := range slices.Sorted(maps.Keys(seen)) {
+			result = append(result, seen[k])
+		}
+
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, e := range v {
+			if e := normalizeIAMPolicyValue(e); e != nil {
+				result[k] = e
+			}
+		}
+
+		if len(result) == 0 {
+			return nil
+		}
+
+		return result
+	default:
+		return v
+	}
+}
+
+// iamPolicyValueKey returns a key used to deduplicate and sort policy element values.
+// Strings sort by their value and other values by their JSON representation.
+func iamPolicyValueKey(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// iamPolicyMaxLength returns the maximum policy document length from the optional variadic argument at the specified position.
+func iamPolicyMaxLength(position int64, maxLengths []int64) (int, *function.FuncError) {
+	switch len(maxLengths) {
+	case 0:
+		return iamPolicyDefaultMaxLength, nil
+	case 1:
+		if maxLengths[0] < 1 {
+			return 0, function.NewArgumentFuncError(position, "max_length must be at least 1")
+		}
+
+		return int(maxLengths[0]), nil
+	default:
+		return 0, function.NewArgumentFuncError(position+1, "at most one max_length may be specified")
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(`
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        { Sid = "S3", Effect = "Allow", Action = ["s3:GetObject"], Resource = "*" },
+        { Effect = "Allow", Action = "ec2:Describe*", Resource = "*" },
+      ]
+    }),
+    jsonencode({
+      Statement = [
+        { Sid = "S3", Effect = "Allow", Action = ["s3:PutObject", "s3:GetObject"], Resource = "*" },
+        { Effect = "Allow", Action = ["ec2:Describe*"], Resource = ["*"] },
+      ]
+    }),
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"S3","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"},{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(`
+    jsonencode({ Statement = [{ Effect = "Allow", Action = "s3:*", Resource = "*" }] }),
+    "invalid",
+`),
+				ExpectError: regexache.MustCompile(`policy[\s\n]*1:`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(policies string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge([%[1]s])
+}
+`, policies)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig(`{
+  Version = "2012-10-17"
+  Statement = {
+    Effect    = "Allow"
+    Action    = ["s3:ListBucket", "s3:GetObject", "s3:GetObject"]
+    Resource  = ["arn:aws:s3:::example/*"]
+    Principal = { AWS = ["arn:aws:iam::123456789012:root", "arn:aws:iam::123456789012:root"] }
+    Condition = {
+      StringEquals = { "aws:PrincipalTag/team" = ["b", "a"] }
+    }
+  }
+}`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::example/*","Condition":{"StringEquals":{"aws:PrincipalTag/team":["a","b"]}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalidEffect(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig(`{ Statement = [{ Effect = "allow", Action = "s3:*", Resource = "*" }] }`, ""),
+				ExpectError: regexache.MustCompile(`Effect[\s\n]*must[\s\n]*be`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_unknownElement(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig(`{ Statement = [{ Effect = "Allow", Actions = "s3:*", Resource = "*" }] }`, ""),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*field[\s\n]*"Actions"`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_maxLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig(`{ Statement = [{ Effect = "Allow", Action = "s3:*", Resource = "*" }] }`, ", 20"),
+				ExpectError: regexache.MustCompile(`exceeds[\s\n]*the[\s\n]*maximum[\s\n]*of[\s\n]*20[\s\n]*characters`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig(policy, maxLength string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_normalize(jsonencode(%[1]s)%[2]s)
+}
+`, policy, maxLength)
+}
@@ -10,6 +10,8 @@ import (
 	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -177,11 +179,17 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys by resource type and, when the effective tag policy is read from a local file, tag key casing and allowed tag values. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `Path to a local AWS Organizations tag policy document to use as the effective tag policy ` +
+					`instead of retrieving required tags from AWS. Only used when tag policy compliance is enforced. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -302,6 +310,40 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with resource tags to default across the resources matching the rule. Rules are evaluated in order.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type patterns, e.g. `aws_s3_*`. The rule does not apply to matching resource types.",
+									},
+									"exclude_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service name patterns, e.g. `ec2`. The rule does not apply to resources of matching services.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type patterns, e.g. `aws_s3_*`. If set, the rule only applies to matching resource types.",
+									},
+									"include_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service name patterns, e.g. `ec2`. If set, the rule only applies to resources of matching services.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to default across the resources matching the rule.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -324,6 +366,71 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 							Description: "Resource tag keys to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
+						"value_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to ignore resource tags across the resources matching the rule.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type patterns, e.g. `aws_s3_*`. The rule does not apply to matching resource types.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type patterns, e.g. `aws_s3_*`. If set, the rule only applies to matching resource types.",
+									},
+									"key_prefixes": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag key prefixes to ignore across the resources matching the rule.",
+									},
+									"keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag keys to ignore across the resources matching the rule.",
+									},
+									"value_patterns": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions matching resource tag values to ignore across the resources matching the rule.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the API requests made to individual AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "Maximum number of in-flight API requests to the service.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0.01),
+							},
+							Description: "Maximum rate of API requests to the service, in requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required: true,
+							Description: "The service to limit. " +
+								"Use the same service name as in the `endpoints` block, e.g. `route53`.",
+						},
 					},
 				},
 			},
@@ -397,6 +504,12 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRAggregateFunction,
+		tffunction.NewCIDRReservedAddressesFunction,
+		tffunction.NewCIDRSubnetsByAZFunction,
+		tffunction.NewCIDRsOverlapFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
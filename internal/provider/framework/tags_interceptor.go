@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -282,8 +284,9 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	reqTags, hasReqTags := policy.RequiredTags[typeName]
+	_, hasEnforcedTags := policy.EnforcedTags[typeName]
+	if !hasReqTags && !hasEnforcedTags {
 		return
 	}
 
@@ -315,21 +318,29 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
-		}
-
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
+		if hasReqTags && !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			summary := "Missing Required Tags"
+			detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			addTagPolicyDiagnostic(&opts.response.Diagnostics, policy.Severity, summary, detail)
+		}
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		if noncompliant := policy.NoncompliantTags(typeName, allPlanTags); len(noncompliant) > 0 {
+			summary := "Noncompliant Tags"
+			detail := fmt.Sprintf("The following tags for %s do not comply with an organizational tag policy: %s", typeName, strings.Join(noncompliant, "; "))
+			addTagPolicyDiagnostic(&opts.response.Diagnostics, policy.Severity, summary, detail)
 		}
 	}
 }
+
+// addTagPolicyDiagnostic adds a diagnostic for a tag policy violation with the specified severity.
+func addTagPolicyDiagnostic(diags *diag.Diagnostics, severity, summary, detail string) {
+	switch severity {
+	case "warning":
+		diags.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+	default:
+		diags.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+	}
+}
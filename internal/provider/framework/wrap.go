@@ -109,6 +109,7 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 		ctx = c.RegisterLogger(ctx)
 		if s := c.RandomnessSource(); s != nil {
 			ctx = vcr.NewContext(ctx, s)
+			ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
 		}
 		ctx = fwflex.RegisterLogger(ctx)
 	}
@@ -271,6 +272,10 @@ func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute get
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
+		if s := c.RandomnessSource(); s != nil {
+			ctx = vcr.NewContext(ctx, s)
+			ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
+		}
 		ctx = fwflex.RegisterLogger(ctx)
 		ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
 	}
@@ -441,6 +446,10 @@ func (w *wrappedAction) context(ctx context.Context, getAttribute getAttributeFu
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
+		if s := c.RandomnessSource(); s != nil {
+			ctx = vcr.NewContext(ctx, s)
+			ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
+		}
 		ctx = fwflex.RegisterLogger(ctx)
 		ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
 	}
@@ -631,6 +640,10 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
+		if s := c.RandomnessSource(); s != nil {
+			ctx = vcr.NewContext(ctx, s)
+			ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
+		}
 		ctx = fwflex.RegisterLogger(ctx)
 	}
 
@@ -925,6 +938,10 @@ func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
+		if s := c.RandomnessSource(); s != nil {
+			ctx = vcr.NewContext(ctx, s)
+			ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
+		}
 		ctx = fwflex.RegisterLogger(ctx)
 	}
 
@@ -1070,6 +1087,10 @@ func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAt
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
+		if s := c.RandomnessSource(); s != nil {
+			ctx = vcr.NewContext(ctx, s)
+			ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
+		}
 		ctx = fwflex.RegisterLogger(ctx)
 	}
 
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestWrappedResourceContext_replayingRecordIfMissing(t *testing.T) { //nolint:paralleltest // t.Setenv is incompatible with t.Parallel
+	t.Setenv("VCR_MODE", "RECORD_IF_MISSING")
+
+	testCases := map[string]struct {
+		cassetteExists bool
+		expected       bool
+	}{
+		"cassette exists": {
+			cassetteExists: true,
+			expected:       true,
+		},
+		"cassette missing": {
+			cassetteExists: false,
+			expected:       false,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // t.Setenv is incompatible with t.Parallel
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			cassetteName := filepath.Join(t.TempDir(), "cassette")
+
+			if testCase.cassetteExists {
+				if err := os.WriteFile(cassetteName+".yaml", []byte("---\nversion: 2\ninteractions: []\n"), 0600); err != nil {
+					t.Fatalf("writing cassette: %s", err)
+				}
+			}
+
+			r, err := recorder.New(cassetteName, recorder.WithMode(recorder.ModeRecordOnce))
+			if err != nil {
+				t.Fatalf("creating recorder: %s", err)
+			}
+			t.Cleanup(func() {
+				if err := r.Stop(); err != nil {
+					t.Errorf("stopping recorder: %s", err)
+				}
+			})
+
+			c := new(conns.AWSClient)
+			c.SetRandomnessSource(rand.NewSource(1))
+			c.SetHTTPClient(ctx, r.GetDefaultClient())
+
+			w := &wrappedResource{
+				servicePackageName: "test",
+				spec: &inttypes.ServicePackageFrameworkResource{
+					Name:     "Test",
+					TypeName: "aws_test",
+				},
+			}
+
+			ctx, diags := w.context(ctx, nil, nil, c)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %s", diags)
+			}
+
+			if got, want := vcr.IsReplaying(ctx), testCase.expected; got != want {
+				t.Errorf("IsReplaying = %t, want %t", got, want)
+			}
+		})
+	}
+}
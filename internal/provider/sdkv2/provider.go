@@ -11,6 +11,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -93,6 +94,45 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tags to default across all resources. " +
 									"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 							},
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with resource tags to default across the resources matching the rule. Rules are evaluated in order.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exclude_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validTagsRulePattern},
+											Description: "Resource type patterns, e.g. `aws_s3_*`. The rule does not apply to matching resource types.",
+										},
+										"exclude_services": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validTagsRulePattern},
+											Description: "Service name patterns, e.g. `ec2`. The rule does not apply to resources of matching services.",
+										},
+										"include_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validTagsRulePattern},
+											Description: "Resource type patterns, e.g. `aws_s3_*`. If set, the rule only applies to matching resource types.",
+										},
+										"include_services": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validTagsRulePattern},
+											Description: "Service name patterns, e.g. `ec2`. If set, the rule only applies to resources of matching services.",
+										},
+										"tags": {
+											Type:        schema.TypeMap,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tags to default across the resources matching the rule.",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -148,6 +188,51 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with settings to ignore resource tags across the resources matching the rule.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exclude_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validTagsRulePattern},
+											Description: "Resource type patterns, e.g. `aws_s3_*`. The rule does not apply to matching resource types.",
+										},
+										"include_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validTagsRulePattern},
+											Description: "Resource type patterns, e.g. `aws_s3_*`. If set, the rule only applies to matching resource types.",
+										},
+										"key_prefixes": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tag key prefixes to ignore across the resources matching the rule.",
+										},
+										"keys": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tag keys to ignore across the resources matching the rule.",
+										},
+										"value_patterns": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+											Description: "Regular expressions matching resource tag values to ignore across the resources matching the rule.",
+										},
+									},
+								},
+							},
+							"value_patterns": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+								Description: "Regular expressions matching resource tag values to ignore across all resources.",
+							},
 						},
 					},
 				},
@@ -209,6 +294,33 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_limits": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings to limit the API requests made to individual AWS services.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_concurrent_requests": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "Maximum number of in-flight API requests to the service.",
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: validation.FloatAtLeast(0.01),
+								Description:  "Maximum rate of API requests to the service, in requests per second.",
+							},
+							"service": {
+								Type:     schema.TypeString,
+								Required: true,
+								Description: "The service to limit. " +
+									"Use the same service name as in the `endpoints` block, e.g. `route53`.",
+							},
+						},
+					},
+				},
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -256,11 +368,18 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys by resource type and, when the effective tag policy is read from a local file, tag key casing and allowed tag values. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `Path to a local AWS Organizations tag policy document to use as the effective tag policy ` +
+						`instead of retrieving required tags from AWS. Only used when tag policy compliance is enforced. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -475,6 +594,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("service_limits"); ok && len(v.([]any)) > 0 {
+		serviceLimits, dx := expandServiceLimits(cty.GetAttrPath("service_limits"), v.([]any))
+		diags = append(diags, dx...)
+		if dx.HasError() {
+			return nil, diags
+		}
+		config.ServiceLimits = serviceLimits
+	}
+
 	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string))
 	diags = append(diags, dg...)
 	if dg.HasError() {
@@ -482,6 +610,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.Get("tag_policy_file").(string); ok && v != "" {
+		config.TagPolicyFile = v
+	} else {
+		config.TagPolicyFile = os.Getenv(tftags.TagPolicyFileEnvVar)
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -770,6 +904,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						ctx = c.RegisterLogger(ctx)
 						if s := c.RandomnessSource(); s != nil {
 							ctx = vcr.NewContext(ctx, s)
+							ctx = vcr.NewReplayContext(ctx, vcr.IsReplayingClient(c.HTTPClient(ctx)))
 						}
 					}
 
@@ -1046,15 +1181,56 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var rules []tftags.DefaultTagsRule
+	if v, ok := tfMap["rule"].([]any); ok {
+		rules = expandDefaultTagsRules(ctx, v)
+	}
+
+	if len(tags) > 0 || len(rules) > 0 {
+		defaultConfig := &tftags.DefaultConfig{
+			Rules: rules,
+		}
+		if len(tags) > 0 {
+			defaultConfig.Tags = tftags.New(ctx, tags)
 		}
+		return defaultConfig
 	}
 
 	return nil
 }
 
+func expandDefaultTagsRules(ctx context.Context, tfList []any) []tftags.DefaultTagsRule {
+	var rules []tftags.DefaultTagsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rule := tftags.DefaultTagsRule{}
+		if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+			rule.Tags = tftags.New(ctx, v)
+		}
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ExcludeServices = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["include_services"].(*schema.Set); ok && v.Len() > 0 {
+			rule.IncludeServices = flex.ExpandStringValueSet(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
@@ -1083,16 +1259,30 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		}
 	}
 
+	var valuePatterns []*regexp.Regexp
+	var rules []tftags.IgnoreTagsRule
+	if tfMap != nil {
+		if v, ok := tfMap["value_patterns"].(*schema.Set); ok {
+			valuePatterns = expandIgnoreTagsValuePatterns(v.List())
+		}
+		if v, ok := tfMap["rule"].([]any); ok {
+			rules = expandIgnoreTagsRules(ctx, v)
+		}
+	}
+
 	// To preseve behavior prior to supporting environment variables:
 	//
 	// - Return nil when no keys or prefixes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(valuePatterns) == 0 && len(rules) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		ValuePatterns: valuePatterns,
+		Rules:         rules,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -1103,6 +1293,86 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandIgnoreTagsRules(ctx context.Context, tfList []any) []tftags.IgnoreTagsRule {
+	var rules []tftags.IgnoreTagsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rule := tftags.IgnoreTagsRule{}
+		if v, ok := tfMap["keys"].(*schema.Set); ok && v.Len() > 0 {
+			rule.Keys = tftags.New(ctx, v.List())
+		}
+		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+			rule.KeyPrefixes = tftags.New(ctx, v.List())
+		}
+		if v, ok := tfMap["value_patterns"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ValuePatterns = expandIgnoreTagsValuePatterns(v.List())
+		}
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandIgnoreTagsValuePatterns(tfList []any) []*regexp.Regexp {
+	var valuePatterns []*regexp.Regexp
+
+	for _, v := range tfList {
+		// Patterns are validated in the schema.
+		if re, err := regexp.Compile(v.(string)); err == nil {
+			valuePatterns = append(valuePatterns, re)
+		}
+	}
+
+	return valuePatterns
+}
+
+func expandServiceLimits(path cty.Path, tfList []any) (map[string]conns.ServiceLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceLimits := make(map[string]conns.ServiceLimit)
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("service"), "Unknown service %q", service))
+			continue
+		}
+		if _, ok := serviceLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("service"), "Duplicate limits for service %q", servicePackageName))
+			continue
+		}
+
+		limit := conns.ServiceLimit{}
+		if v, ok := tfMap["max_concurrent_requests"].(int); ok {
+			limit.MaxConcurrentRequests = v
+		}
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			limit.RequestsPerSecond = v
+		}
+		serviceLimits[servicePackageName] = limit
+	}
+
+	return serviceLimits, diags
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -5,8 +5,10 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -313,8 +315,9 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		reqTags, hasReqTags := policy.RequiredTags[typeName]
+		_, hasEnforcedTags := policy.EnforcedTags[typeName]
+		if !hasReqTags && !hasEnforcedTags {
 			return nil
 		}
 
@@ -335,30 +338,47 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+
+				var errs []error
+				if hasReqTags && !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+					summary := "Missing Required Tags"
+					detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+					if err := tagPolicyError(ctx, policy.Severity, summary, detail); err != nil {
+						errs = append(errs, err)
+					}
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				if noncompliant := policy.NoncompliantTags(typeName, allTags); len(noncompliant) > 0 {
+					summary := "Noncompliant Tags"
+					detail := fmt.Sprintf("The following tags for %s do not comply with an organizational tag policy: %s", typeName, strings.Join(noncompliant, "; "))
+					if err := tagPolicyError(ctx, policy.Severity, summary, detail); err != nil {
+						errs = append(errs, err)
+					}
 				}
+
+				return errors.Join(errs...)
 			}
 		}
 
 		return nil
 	})
 }
+
+// tagPolicyError returns an error for a tag policy violation with "error" severity.
+// CustomizeDiff does not support diagnostics (only an error return).
+func tagPolicyError(ctx context.Context, severity, summary, detail string) error {
+	switch severity {
+	case "warning":
+		// Warning diagnostics are only logged
+		tflog.Warn(ctx, "Required Tags Validation", map[string]any{
+			"summary": summary,
+			"detail":  detail,
+		})
+		return nil
+	default:
+		// Error diagnostics merge summary and detail into a single message
+		return fmt.Errorf("%s - %s", summary, detail)
+	}
+}
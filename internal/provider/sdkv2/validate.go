@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -37,3 +38,12 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validTagsRulePattern validates a string is a well-formed tags rule pattern.
+func validTagsRulePattern(v any, k string) (ws []string, errors []error) {
+	if err := tftags.ValidateTagsRulePattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v, err))
+	}
+
+	return
+}
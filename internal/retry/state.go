@@ -14,7 +14,6 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
-	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 //
@@ -83,10 +82,8 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	delay := backoff.SDKv2HelperRetryCompatibleDelay(conf.Delay, conf.PollInterval, conf.MinTimeout)
 
 	// When VCR testing in replay mode, override the default Delay
-	if inContext, ok := conns.FromContext(ctx); ok && inContext.VCREnabled() {
-		if mode, _ := vcr.Mode(); mode == recorder.ModeReplayOnly {
-			delay = backoff.ZeroDelay
-		}
+	if inContext, ok := conns.FromContext(ctx); ok && inContext.VCREnabled() && vcr.IsReplaying(ctx) {
+		delay = backoff.ZeroDelay
 	}
 
 	var (
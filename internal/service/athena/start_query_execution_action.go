@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startQueryExecutionPollInterval = 3 * time.Second
+)
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionActionModel]
+}
+
+type startQueryExecutionActionModel struct {
+	framework.WithRegionModel
+	Database       types.String `tfsdk:"database"`
+	OutputLocation types.String `tfsdk:"output_location"`
+	QueryString    types.String `tfsdk:"query_string"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	Workgroup      types.String `tfsdk:"workgroup"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Athena query and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database in which the query runs",
+				Optional:    true,
+			},
+			"output_location": schema.StringAttribute{
+				Description: "S3 location in which to store the query results, such as s3://bucket/path/. Required unless the workgroup specifies an output location",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^s3://[a-z0-9][a-z0-9\-]*[a-z0-9](/.*)?$`), "must be a valid S3 URI starting with s3://"),
+				},
+			},
+			"query_string": schema.StringAttribute{
+				Description: "SQL query to run",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 262144),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(86400),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "Name of the workgroup in which the query runs (default: primary)",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting Athena start query execution action", map[string]any{
+		names.AttrDatabase: config.Database.ValueString(),
+		"workgroup":        config.Workgroup.ValueString(),
+		names.AttrTimeout:  timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	input := athena.StartQueryExecutionInput{
+		QueryString: fwflex.StringFromFramework(ctx, config.QueryString),
+		WorkGroup:   fwflex.StringFromFramework(ctx, config.Workgroup),
+	}
+
+	if !config.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Database: fwflex.StringFromFramework(ctx, config.Database),
+		}
+	}
+
+	if !config.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: fwflex.StringFromFramework(ctx, config.OutputLocation),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Query Execution",
+			fmt.Sprintf("Could not start Athena query execution: %s", err),
+		)
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	cb(ctx, "Athena query execution %s started, waiting for completion...", queryExecutionID)
+
+	var lastState awstypes.QueryExecutionState
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		queryExecution, err := findQueryExecutionByID(ctx, conn, queryExecutionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, err
+		}
+
+		if state := queryExecution.Status.State; state != lastState {
+			cb(ctx, "Athena query execution %s is %s", queryExecutionID, state)
+			lastState = state
+		}
+
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(queryExecution.Status.State), Value: queryExecution}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startQueryExecutionPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Athena query execution %s is still %s, continuing to wait for completion...", queryExecutionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			// Stop the query so that it doesn't continue to scan data after the action has given up on it.
+			input := athena.StopQueryExecutionInput{
+				QueryExecutionId: aws.String(queryExecutionID),
+			}
+			if _, err := conn.StopQueryExecution(context.WithoutCancel(ctx), &input); err != nil {
+				tflog.Warn(ctx, "Failed to stop Athena query execution", map[string]any{
+					"query_execution_id": queryExecutionID,
+					"error":              err.Error(),
+				})
+			}
+
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Query Execution to Complete",
+				fmt.Sprintf("Athena query execution %s did not complete within %s and was cancelled: %s", queryExecutionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Query Execution Failed",
+				fmt.Sprintf("Athena query execution %s %s: %s", queryExecutionID, failureErr.Status, queryExecutionFailureReason(fr.Value)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Query Execution State",
+				fmt.Sprintf("Athena query execution %s entered unexpected state: %s", queryExecutionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Query Execution to Complete",
+				fmt.Sprintf("Error while waiting for Athena query execution %s to complete: %s", queryExecutionID, err),
+			)
+		}
+		return
+	}
+
+	var dataScannedInBytes int64
+	var outputLocation string
+	if v := fr.Value; v != nil {
+		if v.Statistics != nil {
+			dataScannedInBytes = aws.ToInt64(v.Statistics.DataScannedInBytes)
+		}
+		if v.ResultConfiguration != nil {
+			outputLocation = aws.ToString(v.ResultConfiguration.OutputLocation)
+		}
+	}
+
+	cb(ctx, "Athena query execution %s %s, %d bytes scanned", queryExecutionID, fr.Status, dataScannedInBytes)
+	if outputLocation != "" {
+		cb(ctx, "Athena query execution %s results written to %s", queryExecutionID, outputLocation)
+	}
+
+	tflog.Info(ctx, "Athena start query execution action completed successfully", map[string]any{
+		"query_execution_id":    queryExecutionID,
+		"data_scanned_in_bytes": dataScannedInBytes,
+		"output_location":       outputLocation,
+	})
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.QueryExecution, nil
+}
+
+// queryExecutionFailureReason returns Athena's explanation of why a query execution failed or was cancelled.
+func queryExecutionFailureReason(queryExecution *awstypes.QueryExecution) string {
+	if queryExecution == nil || queryExecution.Status == nil {
+		return "unknown reason"
+	}
+
+	status := queryExecution.Status
+	reason := aws.ToString(status.StateChangeReason)
+
+	if v := status.AthenaError; v != nil {
+		if reason == "" {
+			reason = aws.ToString(v.ErrorMessage)
+		}
+		if v.ErrorType != nil {
+			reason = fmt.Sprintf("%s (error category %d, type %d)", reason, aws.ToInt32(v.ErrorCategory), aws.ToInt32(v.ErrorType))
+		}
+	}
+
+	if reason == "" {
+		return "unknown reason"
+	}
+
+	return reason
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaStartQueryExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dbName := acctest.RandString(t, 8)
+	resourceName := "aws_athena_database.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartQueryExecutionActionConfig_basic(rName, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, t, resourceName),
+					testAccCheckStartQueryExecutionActionTableExists(ctx, t, dbName, "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaStartQueryExecutionAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dbName := acctest.RandString(t, 8)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartQueryExecutionActionConfig_failure(rName, dbName),
+				ExpectError: regexache.MustCompile(`Query Execution Failed`),
+			},
+		},
+	})
+}
+
+func testAccCheckStartQueryExecutionActionTableExists(ctx context.Context, t *testing.T, databaseName, tableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).AthenaClient(ctx)
+
+		input := athena.GetTableMetadataInput{
+			CatalogName:  aws.String("AwsDataCatalog"),
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+		}
+
+		_, err := conn.GetTableMetadata(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccStartQueryExecutionActionConfig_base(rName, dbName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_database" "test" {
+  name          = %[2]q
+  bucket        = aws_s3_bucket.test.bucket
+  force_destroy = true
+}
+`, rName, dbName)
+}
+
+func testAccStartQueryExecutionActionConfig_basic(rName, dbName string) string {
+	return acctest.ConfigCompose(
+		testAccStartQueryExecutionActionConfig_base(rName, dbName),
+		`
+action "aws_athena_start_query_execution" "test" {
+  config {
+    database        = aws_athena_database.test.name
+    output_location = "s3://${aws_s3_bucket.test.bucket}/results/"
+    query_string    = "CREATE EXTERNAL TABLE test (id int) LOCATION 's3://${aws_s3_bucket.test.bucket}/data/'"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_athena_database.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartQueryExecutionActionConfig_failure(rName, dbName string) string {
+	return acctest.ConfigCompose(
+		testAccStartQueryExecutionActionConfig_base(rName, dbName),
+		`
+action "aws_athena_start_query_execution" "test" {
+  config {
+    database        = aws_athena_database.test.name
+    output_location = "s3://${aws_s3_bucket.test.bucket}/results/"
+    query_string    = "SELECT * FROM does_not_exist"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_athena_database.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,371 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startInstanceRefreshPollInterval defines polling cadence for the start instance refresh action.
+const startInstanceRefreshPollInterval = 15 * time.Second
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshActionModel]
+}
+
+type startInstanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                                        `tfsdk:"autoscaling_group_name"`
+	LaunchTemplate       fwtypes.ListNestedObjectValueOf[instanceRefreshLaunchTemplateModel] `tfsdk:"launch_template"`
+	Preferences          fwtypes.ListNestedObjectValueOf[instanceRefreshPreferencesModel]    `tfsdk:"preferences"`
+	RollbackOnTimeout    types.Bool                                                          `tfsdk:"rollback_on_timeout"`
+	Strategy             fwtypes.StringEnum[awstypes.RefreshStrategy]                        `tfsdk:"strategy"`
+	Timeout              types.Int64                                                         `tfsdk:"timeout"`
+}
+
+type instanceRefreshLaunchTemplateModel struct {
+	LaunchTemplateID   types.String `tfsdk:"id"`
+	LaunchTemplateName types.String `tfsdk:"name"`
+	Version            types.String `tfsdk:"version"`
+}
+
+type instanceRefreshPreferencesModel struct {
+	AutoRollback          types.Bool          `tfsdk:"auto_rollback"`
+	CheckpointDelay       types.Int64         `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages fwtypes.ListOfInt64 `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup        types.Int64         `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage  types.Int64         `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage  types.Int64         `tfsdk:"min_healthy_percentage"`
+	SkipMatching          types.Bool          `tfsdk:"skip_matching"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for it to complete. Optionally rolls the instance refresh back if it does not complete within the timeout.",
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "Name of the Auto Scaling group",
+				Required:    true,
+			},
+			"rollback_on_timeout": schema.BoolAttribute{
+				Description: "Whether to roll back the instance refresh if it does not complete within the timeout",
+				Optional:    true,
+			},
+			"strategy": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RefreshStrategy](),
+				Description: "Strategy to use for the instance refresh (default: Rolling)",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrLaunchTemplate: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[instanceRefreshLaunchTemplateModel](ctx),
+				Description: "Launch template to roll out to the Auto Scaling group. Required to roll back an instance refresh",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Description: "ID of the launch template",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(names.AttrName)),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Description: "Name of the launch template",
+							Optional:    true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Description: "Version of the launch template. Must be a version number to roll back the instance refresh",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"preferences": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[instanceRefreshPreferencesModel](ctx),
+				Description: "Preferences for the instance refresh",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Description: "Whether Amazon EC2 Auto Scaling rolls back the instance refresh if it fails",
+							Optional:    true,
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Description: "Number of seconds to wait after a checkpoint",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							ElementType: types.Int64Type,
+							Description: "Percentages of the instance refresh at which to pause for checkpoint_delay. The last value must be 100",
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(1, 100)),
+							},
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Description: "Number of seconds until a newly launched instance is configured and ready to use",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Description: "Amount of capacity, as a percentage of the desired capacity, that can be in service and healthy, or pending, during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Description: "Amount of capacity, as a percentage of the desired capacity, that must remain healthy during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"skip_matching": schema.BoolAttribute{
+							Description: "Whether to skip replacing instances that already match the desired configuration",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, config.AutoScalingGroupName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+	rollbackOnTimeout := fwflex.BoolValueFromFramework(ctx, config.RollbackOnTimeout)
+
+	tflog.Info(ctx, "Starting Auto Scaling instance refresh action", map[string]any{
+		"autoscaling_group_name": name,
+		"rollback_on_timeout":    rollbackOnTimeout,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting instance refresh for Auto Scaling group %s...", name)
+
+	input := autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Strategy:             config.Strategy.ValueEnum(),
+	}
+
+	if !config.Preferences.IsNull() {
+		resp.Diagnostics.Append(fwflex.Expand(ctx, config.Preferences, &input.Preferences)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !config.LaunchTemplate.IsNull() {
+		input.DesiredConfiguration = &awstypes.DesiredConfiguration{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, config.LaunchTemplate, &input.DesiredConfiguration.LaunchTemplate)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if input.Preferences != nil && aws.ToBool(input.Preferences.AutoRollback) {
+		// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
+		group, err := findGroupByName(ctx, conn, name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Describe Auto Scaling Group",
+				fmt.Sprintf("Could not describe Auto Scaling group %s: %s", name, err),
+			)
+			return
+		}
+
+		input.DesiredConfiguration = &awstypes.DesiredConfiguration{
+			LaunchTemplate:       group.LaunchTemplate,
+			MixedInstancesPolicy: group.MixedInstancesPolicy,
+		}
+	}
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instance Refresh",
+			fmt.Sprintf("Could not start instance refresh for Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+	cb(ctx, "Instance refresh %s started for Auto Scaling group %s, waiting for completion...", id, name)
+
+	refresh, err := waitInstanceRefreshAction(ctx, conn, name, id, timeout, cb)
+
+	var timeoutErr *actionwait.TimeoutError
+	if errors.As(err, &timeoutErr) && rollbackOnTimeout {
+		cb(ctx, "Instance refresh %s did not complete within %s, rolling back...", id, timeout)
+
+		rollbackInput := autoscaling.RollbackInstanceRefreshInput{
+			AutoScalingGroupName: aws.String(name),
+		}
+		if _, rerr := conn.RollbackInstanceRefresh(ctx, &rollbackInput); rerr != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Roll Back Instance Refresh",
+				fmt.Sprintf("Instance refresh %s for Auto Scaling group %s did not complete within %s and could not be rolled back: %s", id, name, timeout, rerr),
+			)
+			return
+		}
+
+		// Allow the rollback as long as the instance refresh was allowed.
+		rollback, rerr := waitInstanceRefreshAction(ctx, conn, name, id, timeout, cb)
+		if rerr != nil {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh Rollback",
+				fmt.Sprintf("Error while waiting for instance refresh %s for Auto Scaling group %s to roll back: %s", id, name, rerr),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Instance Refresh Rolled Back",
+			fmt.Sprintf("Instance refresh %s for Auto Scaling group %s did not complete within %s and was rolled back with status '%s'", id, name, timeout, rollback.Status),
+		)
+		return
+	}
+
+	if err != nil {
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Instance refresh %s for Auto Scaling group %s did not complete within %s: %s", id, name, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance Refresh Status",
+				fmt.Sprintf("Instance refresh %s for Auto Scaling group %s entered unexpected status: %s", id, name, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh",
+				fmt.Sprintf("Error while waiting for instance refresh %s for Auto Scaling group %s: %s", id, name, err),
+			)
+		}
+		return
+	}
+
+	if refresh.Status != awstypes.InstanceRefreshStatusSuccessful {
+		resp.Diagnostics.AddError(
+			"Instance Refresh Failed",
+			fmt.Sprintf("Instance refresh %s for Auto Scaling group %s completed with status '%s': %s", id, name, refresh.Status, aws.ToString(refresh.StatusReason)),
+		)
+		return
+	}
+
+	cb(ctx, "Instance refresh %s for Auto Scaling group %s completed successfully", id, name)
+
+	tflog.Info(ctx, "Auto Scaling instance refresh action completed successfully", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    id,
+	})
+}
+
+// waitInstanceRefreshAction waits for the instance refresh to reach a terminal status, reporting its progress.
+// Terminal statuses other than Successful are returned without error.
+func waitInstanceRefreshAction(ctx context.Context, conn *autoscaling.Client, name, id string, timeout time.Duration, cb fwactions.SendProgressFunc) (*awstypes.InstanceRefresh, error) {
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{id},
+		}
+		refresh, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, fmt.Errorf("describing instance refresh: %w", err)
+		}
+
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(refresh.Status), Value: refresh}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startInstanceRefreshPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusSuccessful),
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			refresh, ok := fr.Value.(*awstypes.InstanceRefresh)
+			if !ok || refresh == nil {
+				cb(ctx, "Instance refresh %s is currently in status '%s'", id, fr.Status)
+				return
+			}
+
+			cb(ctx, "Instance refresh %s is currently in status '%s': %d%% complete, %d instances to update", id, fr.Status, aws.ToInt32(refresh.PercentageComplete), aws.ToInt32(refresh.InstancesToUpdate))
+		},
+	})
+
+	return result.Value, err
+}
@@ -49,5 +49,7 @@ var (
 	FindTrustStoreByID                         = findTrustStoreByID
 	FindVPCOriginByID                          = findVPCOriginByID
 
+	ParseSignerPrivateKey    = parseSignerPrivateKey
+	SignURL                  = signURL
 	WaitDistributionDeployed = waitDistributionDeployed
 )
@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSignURL(t *testing.T) {
+	t.Parallel()
+
+	const (
+		keyPairID = "K2JCJMDEHXQW5F"
+		rawURL    = "https://d111111abcdef8.cloudfront.net/image.jpg?size=large"
+	)
+	privateKey, err := tfcloudfront.ParseSignerPrivateKey(acctest.TLSRSAPrivateKeyPEM(t, 2048))
+	if err != nil {
+		t.Fatalf("parsing private key: %s", err)
+	}
+	expires := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name              string
+		resource          string
+		ipAddress         string
+		expectedPolicy    string
+		expectedCookieKey string
+	}{
+		{
+			name:              "canned policy",
+			expectedPolicy:    `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg?size=large","Condition":{"DateLessThan":{"AWS:EpochTime":1700000000}}}]}`,
+			expectedCookieKey: "CloudFront-Expires",
+		},
+		{
+			name:              "custom policy",
+			resource:          "https://d111111abcdef8.cloudfront.net/*",
+			ipAddress:         "192.0.2.0/24",
+			expectedPolicy:    `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*","Condition":{"DateLessThan":{"AWS:EpochTime":1700000000},"IpAddress":{"AWS:SourceIp":"192.0.2.0/24"}}}]}`,
+			expectedCookieKey: "CloudFront-Policy",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			signedURL, cookies, err := tfcloudfront.SignURL(rawURL, keyPairID, privateKey, expires, testCase.resource, testCase.ipAddress)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !strings.HasPrefix(signedURL, rawURL+"&") {
+				t.Errorf("signed URL %q does not extend %q", signedURL, rawURL)
+			}
+
+			u, err := url.Parse(signedURL)
+			if err != nil {
+				t.Fatalf("parsing signed URL %q: %s", signedURL, err)
+			}
+
+			query := u.Query()
+			if got, want := query.Get("Key-Pair-Id"), keyPairID; got != want {
+				t.Errorf("Key-Pair-Id = %q, want %q", got, want)
+			}
+			if got, want := cookies["CloudFront-Key-Pair-Id"], keyPairID; got != want {
+				t.Errorf("CloudFront-Key-Pair-Id = %q, want %q", got, want)
+			}
+			if got, want := cookies["CloudFront-Signature"], query.Get("Signature"); got != want {
+				t.Errorf("CloudFront-Signature = %q, want %q", got, want)
+			}
+			if _, ok := cookies[testCase.expectedCookieKey]; !ok {
+				t.Errorf("cookie %q is missing", testCase.expectedCookieKey)
+			}
+
+			if testCase.resource == "" && testCase.ipAddress == "" {
+				if got, want := query.Get("Expires"), "1700000000"; got != want {
+					t.Errorf("Expires = %q, want %q", got, want)
+				}
+				if query.Has("Policy") {
+					t.Error("Policy is set for a canned policy")
+				}
+			} else {
+				policy, err := signedURLDecode(query.Get("Policy"))
+				if err != nil {
+					t.Fatalf("decoding Policy: %s", err)
+				}
+				if got, want := string(policy), testCase.expectedPolicy; got != want {
+					t.Errorf("Policy = %q, want %q", got, want)
+				}
+				if query.Has("Expires") {
+					t.Error("Expires is set for a custom policy")
+				}
+			}
+
+			signature, err := signedURLDecode(query.Get("Signature"))
+			if err != nil {
+				t.Fatalf("decoding Signature: %s", err)
+			}
+			hash := sha1.Sum([]byte(testCase.expectedPolicy))
+			if err := rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA1, hash[:], signature); err != nil {
+				t.Errorf("verifying signature: %s", err)
+			}
+		})
+	}
+}
+
+func TestSignURL_invalidURL(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := tfcloudfront.ParseSignerPrivateKey(acctest.TLSRSAPrivateKeyPEM(t, 2048))
+	if err != nil {
+		t.Fatalf("parsing private key: %s", err)
+	}
+
+	for _, rawURL := range []string{"image.jpg", "ftp://d111111abcdef8.cloudfront.net/image.jpg"} {
+		if _, _, err := tfcloudfront.SignURL(rawURL, "K2JCJMDEHXQW5F", privateKey, time.Now(), "", ""); err == nil {
+			t.Errorf("expected error for URL %q", rawURL)
+		}
+	}
+}
+
+func signedURLDecode(v string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(v))
+}
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_basic(acctest.TLSPEMEscapeNewlines(privateKey)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Expires"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Key-Pair-Id"), knownvalue.StringExact("K2JCJMDEHXQW5F")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Signature"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/image\.jpg\?Expires=\d+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_customPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_customPolicy(acctest.TLSPEMEscapeNewlines(privateKey)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Policy"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/image\.jpg\?Policy=[0-9A-Za-z~_-]+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedURLEphemeralResourceConfig_basic(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/image.jpg"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+}
+`, privateKey))
+}
+
+func testAccSignedURLEphemeralResourceConfig_customPolicy(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/image.jpg"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+  resource    = "https://d111111abcdef8.cloudfront.net/*"
+  ip_address  = "192.0.2.0/24"
+  expires_in  = 600
+}
+`, privateKey))
+}
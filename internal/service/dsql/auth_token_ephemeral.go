@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// authTokenMaxExpiresIn is the longest duration for which an authentication token can be valid.
+	authTokenMaxExpiresIn = 7 * 24 * time.Hour
+)
+
+// @EphemeralResource(aws_dsql_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to generate a token for the `admin` role. Otherwise the token is for a custom database role. Default is `false`.",
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Required:    true,
+				Description: "The endpoint of the Aurora DSQL cluster to connect to.",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the token in RFC3339 format.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(authTokenMaxExpiresIn/time.Second)),
+				},
+				Description: "The duration, in seconds, for which the token will remain valid. Value can range from 1 to 604800 seconds. Default is 900 seconds (15 minutes).",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token to use as the password of the database role.",
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fwflex.StringValueFromFramework(ctx, data.Endpoint)
+	expiresIn := authtoken.DefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+	signingTime := time.Now()
+
+	token, err := generateAuthToken(ctx, e.Meta().CredentialsProvider(ctx), endpoint, e.Meta().Region(ctx), data.Admin.ValueBool(), expiresIn, signingTime)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, endpoint)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(signingTime.Add(expiresIn))
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+// generateAuthToken returns a token for connecting to an Aurora DSQL cluster endpoint as the admin role or as a custom database role.
+// The token is signed locally, without calling any AWS API.
+func generateAuthToken(ctx context.Context, credentials aws.CredentialsProvider, endpoint, region string, admin bool, expiresIn time.Duration, signingTime time.Time) (string, error) {
+	action := "DbConnect"
+	if admin {
+		action = "DbConnectAdmin"
+	}
+
+	query := url.Values{
+		"Action": []string{action},
+	}
+
+	return authtoken.Build(ctx, credentials, endpoint, "dsql", region, query, func(o *authtoken.Options) {
+		o.ExpiresIn = expiresIn
+		o.SigningTime = signingTime
+	})
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Admin      types.Bool        `tfsdk:"admin"`
+	Endpoint   types.String      `tfsdk:"endpoint"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	ExpiresIn  types.Int64       `tfsdk:"expires_in"`
+	Token      types.String      `tfsdk:"token"`
+}
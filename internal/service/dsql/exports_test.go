@@ -7,5 +7,6 @@ package dsql
 var (
 	ResourceCluster = newClusterResource
 
-	FindClusterByID = findClusterByID
+	FindClusterByID   = findClusterByID
+	GenerateAuthToken = generateAuthToken
 )
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_hibernate_instances, name="Hibernate Instances")
+func newHibernateInstancesAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &hibernateInstancesAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*hibernateInstancesAction)(nil)
+	_ action.ActionWithConfigValidators = (*hibernateInstancesAction)(nil)
+)
+
+type hibernateInstancesAction struct {
+	framework.ActionWithModel[hibernateInstancesModel]
+}
+
+type hibernateInstancesModel struct {
+	framework.WithRegionModel
+	instancesActionTargetModel
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *hibernateInstancesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := instancesActionTargetAttributes("hibernate")
+	attributes[names.AttrTimeout] = schema.Int64Attribute{
+		Description: "Timeout in seconds to wait for the instances to stop (default: 600)",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(30),
+			int64validator.AtMost(3600),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Hibernates EC2 instances. This action will stop the instances with hibernation and wait for them to reach the stopped state.",
+		Attributes:  attributes,
+	}
+}
+
+func (a *hibernateInstancesAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return instancesActionTargetConfigValidators()
+}
+
+func (a *hibernateInstancesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config hibernateInstancesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	ids, err := findInstancesActionInstanceIDs(ctx, conn, config.instancesActionTargetModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instances",
+			fmt.Sprintf("Could not describe EC2 instances: %s", err),
+		)
+		return
+	}
+
+	if len(ids) == 0 {
+		resp.Diagnostics.AddWarning(
+			"No Instances Found",
+			"No EC2 instances match the specified tags, nothing to hibernate",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting EC2 hibernate instances action", map[string]any{
+		"instance_ids":    ids,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb(ctx, "Hibernating %d EC2 instance(s)...", len(ids))
+
+	input := ec2.StopInstancesInput{
+		Hibernate:   aws.Bool(true),
+		InstanceIds: ids,
+	}
+
+	output, err := conn.StopInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Hibernate Instances",
+			fmt.Sprintf("Could not hibernate EC2 instances %v: %s", ids, err),
+		)
+		return
+	}
+
+	reportInstanceStateChanges(ctx, cb, output.StoppingInstances)
+
+	err = waitInstancesAction(ctx, ids, cb, func(ctx context.Context, id string, cb fwactions.SendProgressFunc) error {
+		instance, err := waitInstanceStopped(ctx, conn, id, timeout)
+		if err != nil {
+			return err
+		}
+
+		cb(ctx, "EC2 instance %s: %s", id, instance.State.Name)
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Instances to Hibernate",
+			fmt.Sprintf("Error while waiting for EC2 instances to hibernate within %s: %s", timeout, err),
+		)
+		return
+	}
+
+	cb(ctx, "%d EC2 instance(s) hibernated successfully", len(ids))
+
+	tflog.Info(ctx, "EC2 hibernate instances action completed successfully", map[string]any{
+		"instance_ids": ids,
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// instancesActionTargetModel selects the EC2 instances that an instances action operates on.
+type instancesActionTargetModel struct {
+	InstanceIDs  fwtypes.ListOfString `tfsdk:"instance_ids"`
+	InstanceTags tftags.Map           `tfsdk:"instance_tags"`
+}
+
+func instancesActionTargetAttributes(verb string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"instance_ids": schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Description: fmt.Sprintf("IDs of the EC2 instances to %s", verb),
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+						"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+					),
+				),
+			},
+		},
+		"instance_tags": schema.MapAttribute{
+			CustomType:  tftags.MapType,
+			ElementType: types.StringType,
+			Description: fmt.Sprintf("Tags that the EC2 instances to %s must all have", verb),
+			Optional:    true,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+			},
+		},
+	}
+}
+
+func instancesActionTargetConfigValidators() []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("instance_tags"),
+		),
+	}
+}
+
+// findInstancesActionInstanceIDs returns the IDs of the specified EC2 instances or of the non-terminated EC2 instances with the specified tags.
+func findInstancesActionInstanceIDs(ctx context.Context, conn *ec2.Client, target instancesActionTargetModel) ([]string, error) {
+	if !target.InstanceIDs.IsNull() {
+		return fwflex.ExpandFrameworkStringValueList(ctx, target.InstanceIDs), nil
+	}
+
+	input := ec2.DescribeInstancesInput{
+		Filters: append([]awstypes.Filter{
+			newFilter("instance-state-name", enum.Slice(
+				awstypes.InstanceStateNamePending,
+				awstypes.InstanceStateNameRunning,
+				awstypes.InstanceStateNameStopping,
+				awstypes.InstanceStateNameStopped,
+			)),
+		}, newTagFilterList(svcTags(tftags.New(ctx, target.InstanceTags)))...),
+	}
+
+	var ids []string
+	for v, err := range listInstances(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, aws.ToString(v.InstanceId))
+	}
+
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// reportInstanceStateChanges reports the state transition of each EC2 instance returned by a start or stop request.
+func reportInstanceStateChanges(ctx context.Context, cb fwactions.SendProgressFunc, changes []awstypes.InstanceStateChange) {
+	for _, v := range changes {
+		var previous, current awstypes.InstanceStateName
+		if v.PreviousState != nil {
+			previous = v.PreviousState.Name
+		}
+		if v.CurrentState != nil {
+			current = v.CurrentState.Name
+		}
+
+		cb(ctx, "EC2 instance %s: %s -> %s", aws.ToString(v.InstanceId), previous, current)
+	}
+}
+
+// waitInstancesAction calls f concurrently for each EC2 instance and returns the joined errors.
+// The progress function passed to f is safe for concurrent use.
+func waitInstancesAction(ctx context.Context, ids []string, cb fwactions.SendProgressFunc, f func(context.Context, string, fwactions.SendProgressFunc) error) error {
+	var mutex sync.Mutex
+	syncCB := func(ctx context.Context, format string, a ...any) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		cb(ctx, format, a...)
+	}
+
+	var g tfsync.Group
+	for _, id := range ids {
+		g.Go(ctx, func(ctx context.Context) error {
+			if err := f(ctx, id, syncCB); err != nil {
+				return fmt.Errorf("EC2 instance %s: %w", id, err)
+			}
+			return nil
+		})
+	}
+
+	return g.Wait(ctx)
+}
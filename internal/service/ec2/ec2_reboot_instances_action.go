@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// rebootInstancesDetectionTimeout is how long to wait for the status checks of a rebooting instance to leave ok.
+	rebootInstancesDetectionTimeout = 3 * time.Minute
+)
+
+// @Action(aws_ec2_reboot_instances, name="Reboot Instances")
+func newRebootInstancesAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootInstancesAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*rebootInstancesAction)(nil)
+	_ action.ActionWithConfigValidators = (*rebootInstancesAction)(nil)
+)
+
+type rebootInstancesAction struct {
+	framework.ActionWithModel[rebootInstancesModel]
+}
+
+type rebootInstancesModel struct {
+	framework.WithRegionModel
+	instancesActionTargetModel
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *rebootInstancesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := instancesActionTargetAttributes("reboot")
+	attributes[names.AttrTimeout] = schema.Int64Attribute{
+		Description: "Timeout in seconds to wait for the instances to reboot and pass their status checks (default: 900)",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(30),
+			int64validator.AtMost(3600),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reboots EC2 instances. This action will request a reboot of the instances and wait for their status checks to detect the reboot and pass again.",
+		Attributes:  attributes,
+	}
+}
+
+func (a *rebootInstancesAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return instancesActionTargetConfigValidators()
+}
+
+func (a *rebootInstancesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootInstancesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 900*time.Second)
+	deadline := time.Now().Add(timeout)
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	ids, err := findInstancesActionInstanceIDs(ctx, conn, config.instancesActionTargetModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instances",
+			fmt.Sprintf("Could not describe EC2 instances: %s", err),
+		)
+		return
+	}
+
+	if len(ids) == 0 {
+		resp.Diagnostics.AddWarning(
+			"No Instances Found",
+			"No EC2 instances match the specified tags, nothing to reboot",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting EC2 reboot instances action", map[string]any{
+		"instance_ids":    ids,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// A reboot can only be detected for instances whose status checks pass beforehand.
+	statusInput := ec2.DescribeInstanceStatusInput{
+		IncludeAllInstances: aws.Bool(true),
+		InstanceIds:         ids,
+	}
+	statuses, err := findInstanceStatuses(ctx, conn, &statusInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instance Status",
+			fmt.Sprintf("Could not describe status of EC2 instances %v: %s", ids, err),
+		)
+		return
+	}
+
+	statusOK := make(map[string]bool, len(statuses))
+	for _, v := range statuses {
+		statusOK[aws.ToString(v.InstanceId)] = v.InstanceStatus != nil && v.InstanceStatus.Status == awstypes.SummaryStatusOk && v.SystemStatus != nil && v.SystemStatus.Status == awstypes.SummaryStatusOk
+	}
+
+	cb(ctx, "Rebooting %d EC2 instance(s)...", len(ids))
+
+	input := ec2.RebootInstancesInput{
+		InstanceIds: ids,
+	}
+
+	if _, err := conn.RebootInstances(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot Instances",
+			fmt.Sprintf("Could not reboot EC2 instances %v: %s", ids, err),
+		)
+		return
+	}
+
+	for _, id := range ids {
+		cb(ctx, "EC2 instance %s: reboot requested", id)
+	}
+
+	// RebootInstances is asynchronous and the instance remains in the running state while it reboots.
+	// The reboot is detected by the status checks leaving ok, which is given a bounded grace period as
+	// a quick reboot can complete between two status checks.
+	detectionTimeout := min(rebootInstancesDetectionTimeout, time.Until(deadline))
+	var mutex sync.Mutex
+	var unconfirmedIDs []string
+
+	err = waitInstancesAction(ctx, ids, cb, func(ctx context.Context, id string, cb fwactions.SendProgressFunc) error {
+		unconfirmed := func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			unconfirmedIDs = append(unconfirmedIDs, id)
+		}
+
+		if !statusOK[id] {
+			cb(ctx, "EC2 instance %s: status checks did not pass before the reboot, waiting for status checks...", id)
+
+			if _, err := waitInstanceStatusRecovered(ctx, conn, id, time.Until(deadline)); err != nil {
+				return fmt.Errorf("waiting for status checks: %w", err)
+			}
+
+			cb(ctx, "EC2 instance %s: status checks passed", id)
+			unconfirmed()
+
+			return nil
+		}
+
+		cb(ctx, "EC2 instance %s: waiting for reboot to start...", id)
+
+		if _, err := waitInstanceStatusNotOK(ctx, conn, id, detectionTimeout); err != nil {
+			if !retry.TimedOut(err) {
+				return fmt.Errorf("waiting for reboot to start: %w", err)
+			}
+
+			cb(ctx, "EC2 instance %s: reboot not detected by status checks within %s", id, detectionTimeout)
+			unconfirmed()
+
+			return nil
+		}
+
+		cb(ctx, "EC2 instance %s: rebooting, waiting for status checks...", id)
+
+		if _, err := waitInstanceStatusRecovered(ctx, conn, id, time.Until(deadline)); err != nil {
+			return fmt.Errorf("waiting for status checks: %w", err)
+		}
+
+		cb(ctx, "EC2 instance %s: rebooted, status checks passed", id)
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Instances to Reboot",
+			fmt.Sprintf("Error while waiting for EC2 instances to reboot within %s: %s", timeout, err),
+		)
+		return
+	}
+
+	if len(unconfirmedIDs) > 0 {
+		slices.Sort(unconfirmedIDs)
+		resp.Diagnostics.AddWarning(
+			"Reboot Not Confirmed",
+			fmt.Sprintf("The reboot of EC2 instances %v could not be confirmed, either because their status checks did not pass before the reboot or because they did not change within %s of the reboot request", unconfirmedIDs, detectionTimeout),
+		)
+	}
+
+	cb(ctx, "%d EC2 instance(s) rebooted successfully", len(ids))
+
+	tflog.Info(ctx, "EC2 reboot instances action completed successfully", map[string]any{
+		"instance_ids": ids,
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2RebootInstancesAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	var bootID string
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRebootInstancesActionConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, t, resourceName, &v),
+					testAccCheckInstanceStatusOK(ctx, t, resourceName),
+					testAccCheckInstanceBootID(ctx, t, resourceName, &bootID),
+				),
+			},
+			{
+				Config: testAccRebootInstancesActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceState(ctx, t, resourceName, awstypes.InstanceStateNameRunning),
+					testAccCheckInstanceRebooted(ctx, t, resourceName, &bootID),
+				),
+			},
+		},
+	})
+}
+
+// errInstanceBootIDNotChanged is returned while the console output does not show a new boot ID.
+var errInstanceBootIDNotChanged = errors.New("boot ID not changed")
+
+// instanceBootIDRegexp matches the boot ID that the test instance writes to its console on every boot.
+var instanceBootIDRegexp = regexache.MustCompile(`tf-acc-test-boot-id=([0-9a-f-]{36})`)
+
+func testAccCheckInstanceStatusOK(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		_, err := tfec2.WaitInstanceStatusOK(ctx, conn, rs.Primary.ID, 15*time.Minute)
+
+		return err
+	}
+}
+
+// testAccCheckInstanceBootID reads the current boot ID of the instance from its console output.
+func testAccCheckInstanceBootID(ctx context.Context, t *testing.T, n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		bootID, err := waitInstanceConsoleBootIDChanged(ctx, conn, rs.Primary.ID, "")
+		if err != nil {
+			return err
+		}
+
+		*v = bootID
+
+		return nil
+	}
+}
+
+// testAccCheckInstanceRebooted checks that the boot ID of the instance differs from the one read before the reboot.
+func testAccCheckInstanceRebooted(ctx context.Context, t *testing.T, n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		if _, err := waitInstanceConsoleBootIDChanged(ctx, conn, rs.Primary.ID, *v); err != nil {
+			return fmt.Errorf("EC2 Instance (%s) was not rebooted: %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// waitInstanceConsoleBootIDChanged waits for the latest boot ID in the console output of an instance to differ from previous.
+// Console output is updated asynchronously, so it is polled.
+func waitInstanceConsoleBootIDChanged(ctx context.Context, conn *ec2.Client, id, previous string) (string, error) {
+	return tfresource.RetryWhen(ctx, 10*time.Minute,
+		func(ctx context.Context) (string, error) {
+			input := ec2.GetConsoleOutputInput{
+				InstanceId: aws.String(id),
+				Latest:     aws.Bool(true),
+			}
+			output, err := conn.GetConsoleOutput(ctx, &input)
+			if err != nil {
+				return "", err
+			}
+
+			consoleOutput, err := base64.StdEncoding.DecodeString(aws.ToString(output.Output))
+			if err != nil {
+				return "", err
+			}
+
+			matches := instanceBootIDRegexp.FindAllStringSubmatch(string(consoleOutput), -1)
+			if len(matches) == 0 {
+				return "", errInstanceBootIDNotChanged
+			}
+
+			if bootID := matches[len(matches)-1][1]; bootID != previous {
+				return bootID, nil
+			}
+
+			return "", errInstanceBootIDNotChanged
+		},
+		func(err error) (bool, error) {
+			if errors.Is(err, errInstanceBootIDNotChanged) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+}
+
+// testAccRebootInstancesActionConfig_base uses a Nitro instance type, as the latest console output is only available on Nitro instances.
+func testAccRebootInstancesActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t3a.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  user_data = <<-EOF
+#cloud-config
+bootcmd:
+  - echo "tf-acc-test-boot-id=$(cat /proc/sys/kernel/random/boot_id)" > /dev/console
+EOF
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccRebootInstancesActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRebootInstancesActionConfig_base(rName), `
+action "aws_ec2_reboot_instances" "test" {
+  config {
+    instance_ids = [aws_instance.test.id]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ec2_reboot_instances.test]
+    }
+  }
+}
+`)
+}
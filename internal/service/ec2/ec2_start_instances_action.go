@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_start_instances, name="Start Instances")
+func newStartInstancesAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstancesAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*startInstancesAction)(nil)
+	_ action.ActionWithConfigValidators = (*startInstancesAction)(nil)
+)
+
+type startInstancesAction struct {
+	framework.ActionWithModel[startInstancesModel]
+}
+
+type startInstancesModel struct {
+	framework.WithRegionModel
+	instancesActionTargetModel
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *startInstancesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := instancesActionTargetAttributes("start")
+	attributes[names.AttrTimeout] = schema.Int64Attribute{
+		Description: "Timeout in seconds to wait for the instances to start and pass their status checks (default: 900)",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(30),
+			int64validator.AtMost(3600),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Starts EC2 instances. This action will start the instances and wait for them to reach the running state and pass their status checks.",
+		Attributes:  attributes,
+	}
+}
+
+func (a *startInstancesAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return instancesActionTargetConfigValidators()
+}
+
+func (a *startInstancesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstancesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 900*time.Second)
+	deadline := time.Now().Add(timeout)
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	ids, err := findInstancesActionInstanceIDs(ctx, conn, config.instancesActionTargetModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instances",
+			fmt.Sprintf("Could not describe EC2 instances: %s", err),
+		)
+		return
+	}
+
+	if len(ids) == 0 {
+		resp.Diagnostics.AddWarning(
+			"No Instances Found",
+			"No EC2 instances match the specified tags, nothing to start",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting EC2 start instances action", map[string]any{
+		"instance_ids":    ids,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb(ctx, "Starting %d EC2 instance(s)...", len(ids))
+
+	input := ec2.StartInstancesInput{
+		InstanceIds: ids,
+	}
+
+	output, err := conn.StartInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instances",
+			fmt.Sprintf("Could not start EC2 instances %v: %s", ids, err),
+		)
+		return
+	}
+
+	reportInstanceStateChanges(ctx, cb, output.StartingInstances)
+
+	err = waitInstancesAction(ctx, ids, cb, func(ctx context.Context, id string, cb fwactions.SendProgressFunc) error {
+		instance, err := waitInstanceStarted(ctx, conn, id, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("waiting for running state: %w", err)
+		}
+
+		cb(ctx, "EC2 instance %s: %s, waiting for status checks...", id, instance.State.Name)
+
+		if _, err := waitInstanceStatusOK(ctx, conn, id, time.Until(deadline)); err != nil {
+			return fmt.Errorf("waiting for status checks: %w", err)
+		}
+
+		cb(ctx, "EC2 instance %s: %s, status checks passed", id, instance.State.Name)
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Instances to Start",
+			fmt.Sprintf("Error while waiting for EC2 instances to start within %s: %s", timeout, err),
+		)
+		return
+	}
+
+	cb(ctx, "%d EC2 instance(s) started successfully", len(ids))
+
+	tflog.Info(ctx, "EC2 start instances action completed successfully", map[string]any{
+		"instance_ids": ids,
+	})
+}
@@ -320,6 +320,7 @@ var (
 	VPCDHCPOptionsAssociationParseResourceID                    = vpcDHCPOptionsAssociationParseResourceID
 	VPCMigrateState                                             = vpcMigrateState
 	VPNGatewayRoutePropagationParseID                           = vpnGatewayRoutePropagationParseID
+	WaitInstanceStatusOK                                        = waitInstanceStatusOK
 	WaitVolumeAttachmentCreated                                 = waitVolumeAttachmentCreated
 	FindGuardDutyVPCEndpoints                                   = findGuardDutyVPCEndpoints
 	FindGuardDutySecurityGroupsForVPC                           = findGuardDutySecurityGroupsForVPC
@@ -24,6 +24,24 @@ type servicePackage struct{}
 
 func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
 	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newHibernateInstancesAction,
+			TypeName: "aws_ec2_hibernate_instances",
+			Name:     "Hibernate Instances",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newRebootInstancesAction,
+			TypeName: "aws_ec2_reboot_instances",
+			Name:     "Reboot Instances",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newStartInstancesAction,
+			TypeName: "aws_ec2_start_instances",
+			Name:     "Start Instances",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
 		{
 			Factory:  newStopInstanceAction,
 			TypeName: "aws_ec2_stop_instance",
@@ -120,6 +120,41 @@ func statusInstance(conn *ec2.Client, id string) retry.StateRefreshFunc {
 	}
 }
 
+// statusInstanceStatusChecks returns the combined result of an instance's instance and system status checks.
+func statusInstanceStatusChecks(conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		input := ec2.DescribeInstanceStatusInput{
+			InstanceIds:         []string{id},
+			IncludeAllInstances: aws.Bool(true),
+		}
+		output, err := findInstanceStatus(ctx, conn, &input)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.InstanceStatus == nil || output.SystemStatus == nil {
+			return output, string(awstypes.SummaryStatusInsufficientData), nil
+		}
+
+		instanceStatus, systemStatus := output.InstanceStatus.Status, output.SystemStatus.Status
+		switch {
+		case instanceStatus == awstypes.SummaryStatusImpaired || systemStatus == awstypes.SummaryStatusImpaired:
+			return output, string(awstypes.SummaryStatusImpaired), nil
+		case instanceStatus == awstypes.SummaryStatusOk && systemStatus == awstypes.SummaryStatusOk:
+			return output, string(awstypes.SummaryStatusOk), nil
+		case instanceStatus == awstypes.SummaryStatusInitializing || systemStatus == awstypes.SummaryStatusInitializing:
+			return output, string(awstypes.SummaryStatusInitializing), nil
+		default:
+			return output, string(awstypes.SummaryStatusInsufficientData), nil
+		}
+	}
+}
+
 func statusInstanceIAMInstanceProfile(conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func(ctx context.Context) (any, string, error) {
 		instance, err := findInstanceByID(ctx, conn, id)
@@ -777,6 +777,64 @@ func waitInstanceStopped(ctx context.Context, conn *ec2.Client, id string, timeo
 	return nil, err
 }
 
+func waitInstanceStatusOK(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.InstanceStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SummaryStatusInitializing, awstypes.SummaryStatusInsufficientData),
+		Target:     enum.Slice(awstypes.SummaryStatusOk),
+		Refresh:    statusInstanceStatusChecks(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitInstanceStatusNotOK waits for the status checks of a rebooting instance to stop reporting ok.
+func waitInstanceStatusNotOK(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.InstanceStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.SummaryStatusOk),
+		Target:       enum.Slice(awstypes.SummaryStatusImpaired, awstypes.SummaryStatusInitializing, awstypes.SummaryStatusInsufficientData),
+		Refresh:      statusInstanceStatusChecks(conn, id),
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitInstanceStatusRecovered waits for the status checks of a rebooted instance to report ok again.
+// Unlike waitInstanceStatusOK, impaired status checks are expected while the instance reboots.
+func waitInstanceStatusRecovered(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.InstanceStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SummaryStatusImpaired, awstypes.SummaryStatusInitializing, awstypes.SummaryStatusInsufficientData),
+		Target:     enum.Slice(awstypes.SummaryStatusOk),
+		Refresh:    statusInstanceStatusChecks(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, conn *ec2.Client, instanceID string, expectedValue *awstypes.CapacityReservationSpecification) (*awstypes.Instance, error) {
 	stateConf := &retry.StateChangeConf{
 		Target:     enum.Slice(strconv.FormatBool(true)),
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// updateServiceDeploymentPollInterval defines polling cadence for the update service deployment action.
+	updateServiceDeploymentPollInterval = 15 * time.Second
+
+	// deploymentRolloutStateReplaced is reported when the deployment is no longer listed on the service,
+	// e.g. because a deployment circuit breaker rolled it back and the rollback has completed.
+	deploymentRolloutStateReplaced = "REPLACED"
+)
+
+// @Action(aws_ecs_update_service_deployment, name="Update Service Deployment")
+func newUpdateServiceDeploymentAction(context.Context) (action.ActionWithConfigure, error) {
+	return &updateServiceDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*updateServiceDeploymentAction)(nil)
+)
+
+type updateServiceDeploymentAction struct {
+	framework.ActionWithModel[updateServiceDeploymentActionModel]
+}
+
+type updateServiceDeploymentActionModel struct {
+	framework.WithRegionModel
+	Cluster        types.String `tfsdk:"cluster"`
+	Service        types.String `tfsdk:"service"`
+	TaskDefinition types.String `tfsdk:"task_definition"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *updateServiceDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service, optionally with a new task definition, and waits for the deployment to complete.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the cluster that hosts the service (default: the default cluster)",
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the service to deploy",
+				Required:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family and revision (family:revision) or full ARN of the task definition to deploy. Defaults to the service's current task definition",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *updateServiceDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateServiceDeploymentActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, config.Cluster)
+	service := fwflex.StringValueFromFramework(ctx, config.Service)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting ECS update service deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		"task_definition": config.TaskDefinition.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting new deployment of ECS service %s...", service)
+
+	input := ecs.UpdateServiceInput{
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+		TaskDefinition:     fwflex.StringFromFramework(ctx, config.TaskDefinition),
+	}
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update ECS Service",
+			fmt.Sprintf("Could not start a new deployment of ECS service %s: %s", service, err),
+		)
+		return
+	}
+
+	primary := findPrimaryTaskSet(output.Service.Deployments)
+	if primary == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update ECS Service",
+			fmt.Sprintf("ECS service %s has no primary deployment after update. Only services using the ECS deployment controller are supported", service),
+		)
+		return
+	}
+
+	id := aws.ToString(primary.Id)
+	cb(ctx, "Deployment %s of task definition %s started, waiting for completion...", id, aws.ToString(primary.TaskDefinition))
+
+	result, err := waitServiceDeploymentAction(ctx, conn, service, cluster, id, timeout, cb)
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for ECS Deployment",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s (last rollout state: %s)", id, service, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"ECS Deployment Failed",
+				deploymentFailureMessage(service, id, result),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected ECS Deployment Rollout State",
+				fmt.Sprintf("Deployment %s of ECS service %s entered unexpected rollout state: %s", id, service, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for ECS Deployment",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s: %s", id, service, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Deployment %s of ECS service %s completed successfully", id, service)
+
+	tflog.Info(ctx, "ECS update service deployment action completed successfully", map[string]any{
+		"cluster":       cluster,
+		"service":       service,
+		"deployment_id": id,
+	})
+}
+
+// waitServiceDeploymentAction waits for the specified deployment of the service to complete, reporting rollout progress.
+// The service returned alongside a failure state is used to explain the failure.
+func waitServiceDeploymentAction(ctx context.Context, conn *ecs.Client, service, cluster, id string, timeout time.Duration, cb fwactions.SendProgressFunc) (*awstypes.Service, error) {
+	var lastState actionwait.Status
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Service], error) {
+		output, err := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, fmt.Errorf("describing ECS service: %w", err)
+		}
+
+		state := actionwait.Status(deploymentRolloutState(output, id))
+		if state != lastState {
+			if deployment := findDeploymentByID(output.Deployments, id); deployment != nil && deployment.RolloutStateReason != nil {
+				cb(ctx, "Deployment %s is %s: %s", id, state, aws.ToString(deployment.RolloutStateReason))
+			} else {
+				cb(ctx, "Deployment %s is %s", id, state)
+			}
+			lastState = state
+		}
+
+		return actionwait.FetchResult[*awstypes.Service]{Status: state, Value: output}, nil
+	}, actionwait.Options[*awstypes.Service]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(updateServiceDeploymentPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+			deploymentRolloutStateReplaced,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			output, ok := fr.Value.(*awstypes.Service)
+			if !ok || output == nil {
+				return
+			}
+
+			if deployment := findDeploymentByID(output.Deployments, id); deployment != nil {
+				cb(ctx, "Deployment %s is %s: %d running, %d pending, %d desired, %d failed tasks", id, fr.Status, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount, deployment.FailedTasks)
+			}
+		},
+	})
+
+	return result.Value, err
+}
+
+// deploymentRolloutState returns the rollout state of the specified deployment of the service.
+func deploymentRolloutState(service *awstypes.Service, id string) string {
+	deployment := findDeploymentByID(service.Deployments, id)
+	if deployment == nil {
+		return deploymentRolloutStateReplaced
+	}
+
+	if deployment.RolloutState != "" {
+		return string(deployment.RolloutState)
+	}
+
+	// Rollout state is only reported for the ECS deployment controller.
+	// Otherwise, the deployment is complete once it is the only deployment and all of its tasks are running.
+	if len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount {
+		return string(awstypes.DeploymentRolloutStateCompleted)
+	}
+
+	return string(awstypes.DeploymentRolloutStateInProgress)
+}
+
+// deploymentFailureMessage describes why the specified deployment of the service failed,
+// including whether a deployment circuit breaker rolled it back.
+func deploymentFailureMessage(service, id string, output *awstypes.Service) string {
+	message := fmt.Sprintf("Deployment %s of ECS service %s failed", id, service)
+	if output == nil {
+		return message
+	}
+
+	if deployment := findDeploymentByID(output.Deployments, id); deployment != nil && deployment.RolloutStateReason != nil {
+		message += ": " + aws.ToString(deployment.RolloutStateReason)
+	}
+
+	if v := output.DeploymentConfiguration; v != nil && v.DeploymentCircuitBreaker != nil && v.DeploymentCircuitBreaker.Rollback {
+		if primary := findPrimaryTaskSet(output.Deployments); primary != nil && aws.ToString(primary.Id) != id {
+			message += fmt.Sprintf(". The deployment circuit breaker rolled the service back to task definition %s (deployment %s)", aws.ToString(primary.TaskDefinition), aws.ToString(primary.Id))
+		}
+	}
+
+	return message
+}
+
+func findDeploymentByID(deployments []awstypes.Deployment, id string) *awstypes.Deployment {
+	for _, deployment := range deployments {
+		if aws.ToString(deployment.Id) == id {
+			return &deployment
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSUpdateServiceDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateServiceDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, t, resourceName, &service),
+					testAccCheckUpdateServiceDeploymentActionCompleted(&service, "aws_ecs_task_definition.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSUpdateServiceDeploymentAction_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateServiceDeploymentActionConfig_taskDefinition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, t, resourceName, &service),
+					testAccCheckUpdateServiceDeploymentActionCompleted(&service, "aws_ecs_task_definition.update"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckUpdateServiceDeploymentActionCompleted verifies that the service's only deployment was created
+// after the service, completed, and runs the specified task definition.
+func testAccCheckUpdateServiceDeploymentActionCompleted(service *awstypes.Service, taskDefinitionResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[taskDefinitionResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", taskDefinitionResourceName)
+		}
+
+		if got := len(service.Deployments); got != 1 {
+			return fmt.Errorf("ECS Service %s has %d deployments, expected 1", aws.ToString(service.ServiceName), got)
+		}
+
+		deployment := service.Deployments[0]
+
+		if deployment.RolloutState != awstypes.DeploymentRolloutStateCompleted {
+			return fmt.Errorf("ECS Service %s deployment %s has rollout state %s, expected %s", aws.ToString(service.ServiceName), aws.ToString(deployment.Id), deployment.RolloutState, awstypes.DeploymentRolloutStateCompleted)
+		}
+
+		if !aws.ToTime(deployment.CreatedAt).After(aws.ToTime(service.CreatedAt)) {
+			return fmt.Errorf("ECS Service %s deployment %s was not created by the action", aws.ToString(service.ServiceName), aws.ToString(deployment.Id))
+		}
+
+		if got, expected := aws.ToString(deployment.TaskDefinition), rs.Primary.Attributes[names.AttrARN]; got != expected {
+			return fmt.Errorf("ECS Service %s deployment %s has task definition %s, expected %s", aws.ToString(service.ServiceName), aws.ToString(deployment.Id), got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateServiceDeploymentActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = true
+  }
+
+  wait_for_steady_state = true
+
+  lifecycle {
+    ignore_changes = [task_definition]
+  }
+}
+`, rName))
+}
+
+func testAccUpdateServiceDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUpdateServiceDeploymentActionConfig_base(rName), `
+action "aws_ecs_update_service_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_service.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_update_service_deployment.test]
+    }
+  }
+}
+`)
+}
+
+func testAccUpdateServiceDeploymentActionConfig_taskDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccUpdateServiceDeploymentActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "update" {
+  family                   = "%[1]s-update"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 256,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 512,
+    "name": "mongodb",
+    "networkMode": "awsvpc"
+  }
+]
+DEFINITION
+}
+
+action "aws_ecs_update_service_deployment" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.arn
+    service         = aws_ecs_service.test.name
+    task_definition = aws_ecs_task_definition.update.arn
+    timeout         = 1200
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_task_definition.update.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_update_service_deployment.test]
+    }
+  }
+
+  depends_on = [aws_ecs_service.test]
+}
+`, rName))
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+// @EphemeralResource(aws_elasticache_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigValidators = (*authTokenEphemeralResource)(nil)
+)
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the token in RFC3339 format.",
+			},
+			"replication_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the replication group to connect to.",
+			},
+			"serverless_cache_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the serverless cache to connect to.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token to use as the password of the user.",
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the IAM-enabled user to connect as.",
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) ConfigValidators(context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("replication_group_id"),
+			path.MatchRoot("serverless_cache_name"),
+		),
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{
+		"Action": []string{"connect"},
+		"User":   []string{fwflex.StringValueFromFramework(ctx, data.UserID)},
+	}
+
+	cacheName := fwflex.StringValueFromFramework(ctx, data.ReplicationGroupID)
+	if !data.ServerlessCacheName.IsNull() {
+		cacheName = fwflex.StringValueFromFramework(ctx, data.ServerlessCacheName)
+		query.Set("ResourceType", "ServerlessCache")
+	}
+
+	signingTime := time.Now()
+
+	token, err := authtoken.Build(ctx, e.Meta().CredentialsProvider(ctx), cacheName, "elasticache", e.Meta().Region(ctx), query, func(o *authtoken.Options) {
+		o.SigningTime = signingTime
+	})
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, cacheName)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(signingTime.Add(authtoken.DefaultExpiresIn))
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration          timetypes.RFC3339 `tfsdk:"expiration"`
+	ReplicationGroupID  types.String      `tfsdk:"replication_group_id"`
+	ServerlessCacheName types.String      `tfsdk:"serverless_cache_name"`
+	Token               types.String      `tfsdk:"token"`
+	UserID              types.String      `tfsdk:"user_id"`
+}
@@ -22,6 +22,23 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newStartCrawlerAction,
+			TypeName: "aws_glue_start_crawler",
+			Name:     "Start Crawler",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newStartJobRunAction,
+			TypeName: "aws_glue_start_job_run",
+			Name:     "Start Job Run",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startCrawlerPollInterval = 15 * time.Second
+
+	// crawlerStatusStarting is reported while the crawler is READY but the requested crawl has not yet begun.
+	crawlerStatusStarting = "STARTING"
+)
+
+// @Action(aws_glue_start_crawler, name="Start Crawler")
+func newStartCrawlerAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startCrawlerAction{}, nil
+}
+
+var (
+	_ action.Action = (*startCrawlerAction)(nil)
+)
+
+type startCrawlerAction struct {
+	framework.ActionWithModel[startCrawlerActionModel]
+}
+
+type startCrawlerActionModel struct {
+	framework.WithRegionModel
+	CrawlerName types.String `tfsdk:"crawler_name"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startCrawlerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a Glue crawler and waits for the crawl to complete.",
+		Attributes: map[string]schema.Attribute{
+			"crawler_name": schema.StringAttribute{
+				Description: "Name of the Glue crawler to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the crawl to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startCrawlerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startCrawlerActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	crawlerName := fwflex.StringValueFromFramework(ctx, config.CrawlerName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting Glue start crawler action", map[string]any{
+		"crawler_name":    crawlerName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	// The crawler reports the outcome of the most recent crawl only, so remember which crawl
+	// was the most recent before starting this one.
+	crawler, err := findCrawlerByName(ctx, conn, crawlerName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Crawler",
+			fmt.Sprintf("Could not read Glue crawler %s: %s", crawlerName, err),
+		)
+		return
+	}
+
+	var previousStartTime time.Time
+	if crawler.LastCrawl != nil {
+		previousStartTime = aws.ToTime(crawler.LastCrawl.StartTime)
+	}
+
+	input := glue.StartCrawlerInput{
+		Name: aws.String(crawlerName),
+	}
+
+	if _, err := conn.StartCrawler(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Crawler",
+			fmt.Sprintf("Could not start Glue crawler %s: %s", crawlerName, err),
+		)
+		return
+	}
+
+	cb(ctx, "Glue crawler %s started, waiting for completion...", crawlerName)
+
+	var lastStatus actionwait.Status
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Crawler], error) {
+		crawler, err := findCrawlerByName(ctx, conn, crawlerName)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Crawler]{}, err
+		}
+
+		status := crawlStatus(crawler, previousStartTime)
+		if status != lastStatus {
+			cb(ctx, "Glue crawler %s is %s", crawlerName, status)
+			lastStatus = status
+		}
+
+		return actionwait.FetchResult[*awstypes.Crawler]{Status: status, Value: crawler}, nil
+	}, actionwait.Options[*awstypes.Crawler]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startCrawlerPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.LastCrawlStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			crawlerStatusStarting,
+			actionwait.Status(awstypes.CrawlerStateRunning),
+			actionwait.Status(awstypes.CrawlerStateStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.LastCrawlStatusCancelled),
+			actionwait.Status(awstypes.LastCrawlStatusFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Glue crawler %s is still %s, continuing to wait for completion...", crawlerName, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Crawler to Complete",
+				fmt.Sprintf("Glue crawler %s did not complete within %s: %s", crawlerName, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			var errorMessage string
+			if fr.Value != nil && fr.Value.LastCrawl != nil {
+				errorMessage = aws.ToString(fr.Value.LastCrawl.ErrorMessage)
+			}
+			resp.Diagnostics.AddError(
+				"Crawl Failed",
+				fmt.Sprintf("Glue crawler %s %s: %s", crawlerName, failureErr.Status, errorMessage),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Crawler State",
+				fmt.Sprintf("Glue crawler %s entered unexpected state: %s", crawlerName, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Crawler to Complete",
+				fmt.Sprintf("Error while waiting for Glue crawler %s to complete: %s", crawlerName, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Glue crawler %s completed successfully", crawlerName)
+
+	tflog.Info(ctx, "Glue start crawler action completed successfully", map[string]any{
+		"crawler_name": crawlerName,
+	})
+}
+
+// crawlStatus returns the state of a running crawler, or the outcome of the crawl that started after previousStartTime once the crawler is ready again.
+func crawlStatus(crawler *awstypes.Crawler, previousStartTime time.Time) actionwait.Status {
+	if crawler.State != awstypes.CrawlerStateReady {
+		return actionwait.Status(crawler.State)
+	}
+
+	if crawler.LastCrawl == nil || !aws.ToTime(crawler.LastCrawl.StartTime).After(previousStartTime) {
+		return crawlerStatusStarting
+	}
+
+	return actionwait.Status(crawler.LastCrawl.Status)
+}
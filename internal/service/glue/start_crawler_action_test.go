@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueStartCrawlerAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var crawler awstypes.Crawler
+	resourceName := "aws_glue_crawler.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GlueEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckCrawlerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartCrawlerActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCrawlerExists(ctx, t, resourceName, &crawler),
+					testAccCheckStartCrawlerActionSucceeded(&crawler),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStartCrawlerActionSucceeded(crawler *awstypes.Crawler) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if crawler.State != awstypes.CrawlerStateReady {
+			return fmt.Errorf("Glue Crawler state = %s, expected %s", crawler.State, awstypes.CrawlerStateReady)
+		}
+
+		if crawler.LastCrawl == nil {
+			return fmt.Errorf("Glue Crawler has not run")
+		}
+
+		if crawler.LastCrawl.Status != awstypes.LastCrawlStatusSucceeded {
+			return fmt.Errorf("Glue Crawler last crawl status = %s, expected %s", crawler.LastCrawl.Status, awstypes.LastCrawlStatusSucceeded)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartCrawlerActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCrawlerConfig_base(rName), fmt.Sprintf(`
+# The AWSGlueServiceRole managed policy grants access to S3 buckets whose names start with aws-glue-.
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-glue-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_crawler" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test-AWSGlueServiceRole]
+
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  role          = aws_iam_role.test.name
+
+  s3_target {
+    path = "s3://${aws_s3_bucket.test.bucket}"
+  }
+}
+
+action "aws_glue_start_crawler" "test" {
+  config {
+    crawler_name = aws_glue_crawler.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_glue_crawler.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_glue_start_crawler.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueStartJobRunAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job awstypes.Job
+	resourceName := "aws_glue_job.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GlueEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartJobRunActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, &job),
+					testAccCheckStartJobRunActionSucceeded(ctx, t, rName, map[string]string{
+						"--message": "hello",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStartJobRunActionSucceeded(ctx context.Context, t *testing.T, jobName string, arguments map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).GlueClient(ctx)
+
+		input := glue.GetJobRunsInput{
+			JobName: aws.String(jobName),
+		}
+		output, err := conn.GetJobRuns(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if len(output.JobRuns) == 0 {
+			return fmt.Errorf("Glue Job %s has no runs", jobName)
+		}
+
+		// Job runs are returned most recent first.
+		jobRun := output.JobRuns[0]
+		if jobRun.JobRunState != awstypes.JobRunStateSucceeded {
+			return fmt.Errorf("Glue Job %s run %s state = %s, expected %s", jobName, aws.ToString(jobRun.Id), jobRun.JobRunState, awstypes.JobRunStateSucceeded)
+		}
+
+		for k, want := range arguments {
+			if got := jobRun.Arguments[k]; got != want {
+				return fmt.Errorf("Glue Job %s run argument %s = %q, expected %q", jobName, k, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStartJobRunActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+# The AWSGlueServiceRole managed policy grants access to S3 buckets whose names start with aws-glue-.
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-glue-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "script.py"
+  content = "print('done')\n"
+}
+
+resource "aws_glue_job" "test" {
+  max_capacity = 0.0625
+  name         = %[1]q
+  role_arn     = aws_iam_role.test.arn
+
+  command {
+    name            = "pythonshell"
+    python_version  = "3.9"
+    script_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+
+action "aws_glue_start_job_run" "test" {
+  config {
+    job_name = aws_glue_job.test.name
+
+    arguments = {
+      "--message" = "hello"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_glue_job.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_glue_start_job_run.test]
+    }
+  }
+}
+`, rName))
+}
@@ -30,8 +30,15 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 			Name:     "Invoke",
 			Region:   inttypes.ResourceRegionDefault(),
 		},
+		{
+			Factory:  newShiftAliasTrafficAction,
+			TypeName: "aws_lambda_shift_alias_traffic",
+			Name:     "Shift Alias Traffic",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
 	}
 }
+
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
 		{
@@ -0,0 +1,386 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	shiftAliasTrafficAlarmPollInterval = 10 * time.Second
+)
+
+// @Action(aws_lambda_shift_alias_traffic, name="Shift Alias Traffic")
+func newShiftAliasTrafficAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &shiftAliasTrafficAction{}, nil
+}
+
+var (
+	_ action.Action = (*shiftAliasTrafficAction)(nil)
+)
+
+type shiftAliasTrafficAction struct {
+	framework.ActionWithModel[shiftAliasTrafficActionModel]
+}
+
+type shiftAliasTrafficActionModel struct {
+	framework.WithRegionModel
+	AlarmNames      fwtypes.ListOfString `tfsdk:"alarm_names"`
+	AliasName       types.String         `tfsdk:"alias_name"`
+	FunctionName    types.String         `tfsdk:"function_name"`
+	FunctionVersion types.String         `tfsdk:"function_version"`
+	StepInterval    types.Int64          `tfsdk:"step_interval"`
+	StepPercentage  types.Int64          `tfsdk:"step_percentage"`
+	Timeout         types.Int64          `tfsdk:"timeout"`
+}
+
+func (a *shiftAliasTrafficAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gradually shifts the traffic of a Lambda alias to a new function version. Traffic is shifted in steps, and the shift is rolled back if any of the specified CloudWatch alarms goes into the ALARM state.",
+		Attributes: map[string]schema.Attribute{
+			"alarm_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "Names of the CloudWatch metric or composite alarms to watch while traffic is shifted",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Description: "Name of the Lambda alias to shift",
+				Required:    true,
+			},
+			"function_name": schema.StringAttribute{
+				Description: "Name or ARN of the Lambda function",
+				Required:    true,
+			},
+			"function_version": schema.StringAttribute{
+				Description: "Function version to shift traffic to. If not specified, a new version is published from $LATEST",
+				Optional:    true,
+			},
+			"step_interval": schema.Int64Attribute{
+				Description: "Time in seconds to wait between steps while watching the alarms (default: 60)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(3600),
+				},
+			},
+			"step_percentage": schema.Int64Attribute{
+				Description: "Percentage of traffic to shift to the new version at each step (default: 10)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the traffic shift to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *shiftAliasTrafficAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config shiftAliasTrafficActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+	cloudWatchConn := a.Meta().CloudWatchClient(ctx)
+
+	functionName := fwflex.StringValueFromFramework(ctx, config.FunctionName)
+	aliasName := fwflex.StringValueFromFramework(ctx, config.AliasName)
+	alarmNames := fwflex.ExpandFrameworkStringValueList(ctx, config.AlarmNames)
+	stepPercentage := int64(10)
+	if !config.StepPercentage.IsNull() {
+		stepPercentage = config.StepPercentage.ValueInt64()
+	}
+	stepInterval := fwactions.TimeoutOr(config.StepInterval, 60*time.Second)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting Lambda shift alias traffic action", map[string]any{
+		"function_name":   functionName,
+		"alias_name":      aliasName,
+		"alarm_names":     alarmNames,
+		"step_percentage": stepPercentage,
+		"step_interval":   stepInterval.String(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	alias, err := findAliasByTwoPartKey(ctx, conn, functionName, aliasName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Lambda Alias",
+			fmt.Sprintf("Could not read Lambda alias %s of function %s: %s", aliasName, functionName, err),
+		)
+		return
+	}
+
+	currentVersion := aws.ToString(alias.FunctionVersion)
+
+	// Rolling back restores the alias to a single version, so the alias must not already route traffic to other versions.
+	if alias.RoutingConfig != nil && len(alias.RoutingConfig.AdditionalVersionWeights) > 0 {
+		resp.Diagnostics.AddError(
+			"Lambda Alias Already Shifting Traffic",
+			fmt.Sprintf("Not shifting traffic of Lambda alias %s, it already routes traffic to additional versions %v", aliasName, slices.Sorted(maps.Keys(alias.RoutingConfig.AdditionalVersionWeights))),
+		)
+		return
+	}
+
+	if len(alarmNames) > 0 {
+		alarming, err := findAlarmingAlarms(ctx, cloudWatchConn, alarmNames)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Describe CloudWatch Alarms",
+				fmt.Sprintf("Could not describe CloudWatch alarms %v: %s", alarmNames, err),
+			)
+			return
+		}
+
+		if len(alarming) > 0 {
+			resp.Diagnostics.AddError(
+				"CloudWatch Alarms in ALARM State",
+				fmt.Sprintf("Not shifting traffic of Lambda alias %s, CloudWatch alarms %v are already in the ALARM state", aliasName, alarming),
+			)
+			return
+		}
+	}
+
+	targetVersion := fwflex.StringValueFromFramework(ctx, config.FunctionVersion)
+	if targetVersion == "" {
+		cb(ctx, "Publishing new version of Lambda function %s...", functionName)
+
+		version, err := publishFunctionVersion(ctx, conn, functionName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Publish Lambda Function Version",
+				fmt.Sprintf("Could not publish a version of Lambda function %s: %s", functionName, err),
+			)
+			return
+		}
+
+		targetVersion = version
+		cb(ctx, "Published version %s of Lambda function %s", targetVersion, functionName)
+	}
+
+	if targetVersion == currentVersion {
+		cb(ctx, "Lambda alias %s already points to version %s, nothing to shift", aliasName, targetVersion)
+		return
+	}
+
+	cb(ctx, "Shifting traffic of Lambda alias %s from version %s to version %s in steps of %d%%...", aliasName, currentVersion, targetVersion, stepPercentage)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// rollback restores all traffic to the original version. It runs on a context without
+	// the deadline so that a rollback is still attempted after the action times out.
+	rollback := func(reason string) {
+		cb(ctx, "Rolling back Lambda alias %s to version %s: %s", aliasName, currentVersion, reason)
+
+		if err := updateAliasRouting(context.WithoutCancel(ctx), conn, functionName, aliasName, currentVersion, "", 0); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Roll Back Lambda Alias",
+				fmt.Sprintf("Could not roll back Lambda alias %s to version %s: %s", aliasName, currentVersion, err),
+			)
+			return
+		}
+
+		cb(ctx, "Lambda alias %s rolled back to version %s", aliasName, currentVersion)
+	}
+
+	for weight := stepPercentage; weight < 100; weight += stepPercentage {
+		if err := updateAliasRouting(ctx, conn, functionName, aliasName, currentVersion, targetVersion, weight); err != nil {
+			rollback(err.Error())
+			resp.Diagnostics.AddError(
+				"Failed to Shift Lambda Alias Traffic",
+				fmt.Sprintf("Could not shift %d%% of Lambda alias %s traffic to version %s: %s", weight, aliasName, targetVersion, err),
+			)
+			return
+		}
+
+		cb(ctx, "Shifted %d%% of Lambda alias %s traffic to version %s", weight, aliasName, targetVersion)
+
+		alarming, err := watchAlarms(ctx, cloudWatchConn, alarmNames, stepInterval)
+		if err != nil {
+			rollback(err.Error())
+			resp.Diagnostics.AddError(
+				"Error Watching CloudWatch Alarms",
+				fmt.Sprintf("Error while watching CloudWatch alarms during Lambda alias %s traffic shift: %s", aliasName, err),
+			)
+			return
+		}
+
+		if len(alarming) > 0 {
+			rollback(fmt.Sprintf("CloudWatch alarms %v in ALARM state", alarming))
+			resp.Diagnostics.AddError(
+				"Lambda Alias Traffic Shift Rolled Back",
+				fmt.Sprintf("CloudWatch alarms %v went into the ALARM state with %d%% of Lambda alias %s traffic on version %s", alarming, weight, aliasName, targetVersion),
+			)
+			return
+		}
+	}
+
+	if err := updateAliasRouting(ctx, conn, functionName, aliasName, targetVersion, "", 0); err != nil {
+		rollback(err.Error())
+		resp.Diagnostics.AddError(
+			"Failed to Shift Lambda Alias Traffic",
+			fmt.Sprintf("Could not shift all Lambda alias %s traffic to version %s: %s", aliasName, targetVersion, err),
+		)
+		return
+	}
+
+	cb(ctx, "Shifted 100%% of Lambda alias %s traffic to version %s", aliasName, targetVersion)
+
+	tflog.Info(ctx, "Lambda shift alias traffic action completed successfully", map[string]any{
+		"function_name":    functionName,
+		"alias_name":       aliasName,
+		"previous_version": currentVersion,
+		"function_version": targetVersion,
+	})
+}
+
+// publishFunctionVersion publishes a version from the function's $LATEST code and configuration and waits for it to be ready.
+func publishFunctionVersion(ctx context.Context, conn *lambda.Client, functionName string) (string, error) {
+	input := lambda.PublishVersionInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	output, err := tfresource.RetryWhenIsAErrorMessageContains[*lambda.PublishVersionOutput, *awstypes.ResourceConflictException](ctx, lambdaPropagationTimeout, func(ctx context.Context) (*lambda.PublishVersionOutput, error) {
+		return conn.PublishVersion(ctx, &input)
+	}, "in progress")
+
+	if err != nil {
+		return "", err
+	}
+
+	version := aws.ToString(output.Version)
+
+	if _, err := waitFunctionConfigurationUpdated(ctx, conn, aws.ToString(output.FunctionArn), version, lambdaPropagationTimeout); err != nil {
+		return "", fmt.Errorf("waiting for version %s: %w", version, err)
+	}
+
+	return version, nil
+}
+
+// updateAliasRouting points the alias at primaryVersion and, if additionalVersion is set, routes weight percent of its traffic to additionalVersion.
+func updateAliasRouting(ctx context.Context, conn *lambda.Client, functionName, aliasName, primaryVersion, additionalVersion string, weight int64) error {
+	input := lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		FunctionVersion: aws.String(primaryVersion),
+		Name:            aws.String(aliasName),
+		RoutingConfig:   &awstypes.AliasRoutingConfiguration{},
+	}
+
+	if additionalVersion != "" {
+		input.RoutingConfig.AdditionalVersionWeights = map[string]float64{
+			additionalVersion: float64(weight) / 100,
+		}
+	}
+
+	_, err := conn.UpdateAlias(ctx, &input)
+
+	return err
+}
+
+// watchAlarms polls the alarms for the given duration and returns the names of any alarms that go into the ALARM state.
+func watchAlarms(ctx context.Context, conn *cloudwatch.Client, alarmNames []string, d time.Duration) ([]string, error) {
+	deadline := time.Now().Add(d)
+
+	for {
+		if len(alarmNames) > 0 {
+			alarming, err := findAlarmingAlarms(ctx, conn, alarmNames)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(alarming) > 0 {
+				return alarming, nil
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(min(remaining, shiftAliasTrafficAlarmPollInterval)):
+		}
+	}
+}
+
+// findAlarmingAlarms returns the names of the specified CloudWatch alarms that are in the ALARM state.
+// An error is returned if any of the alarms does not exist.
+func findAlarmingAlarms(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]string, error) {
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+	}
+
+	found := make(map[string]cloudwatchtypes.StateValue, len(alarmNames))
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricAlarms {
+			found[aws.ToString(v.AlarmName)] = v.StateValue
+		}
+		for _, v := range page.CompositeAlarms {
+			found[aws.ToString(v.AlarmName)] = v.StateValue
+		}
+	}
+
+	var alarming []string
+	for _, name := range alarmNames {
+		state, ok := found[name]
+		if !ok {
+			return nil, fmt.Errorf("CloudWatch alarm %s not found", name)
+		}
+
+		if state == cloudwatchtypes.StateValueAlarm {
+			alarming = append(alarming, name)
+		}
+	}
+
+	return alarming, nil
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaShiftAliasTrafficAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckAliasDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_base(rName, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, t, resourceName, &v),
+					testAccCheckShiftAliasTrafficActionVersion(&v, "1"),
+				),
+			},
+			{
+				Config: testAccShiftAliasTrafficActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, t, resourceName, &v),
+					testAccCheckShiftAliasTrafficActionVersion(&v, "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaShiftAliasTrafficAction_alarms(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckAliasDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_base(rName, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, t, resourceName, &v),
+					testAccCheckShiftAliasTrafficActionVersion(&v, "1"),
+				),
+			},
+			{
+				Config: testAccShiftAliasTrafficActionConfig_alarms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, t, resourceName, &v),
+					testAccCheckShiftAliasTrafficActionVersion(&v, "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaShiftAliasTrafficAction_existingRoutingConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckAliasDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_base(rName, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, t, resourceName, &v),
+					testAccCheckShiftAliasTrafficActionVersion(&v, "1"),
+				),
+			},
+			{
+				// Publishes version 2.
+				Config: testAccShiftAliasTrafficActionConfig_base(rName, "v2", true),
+			},
+			{
+				PreConfig:   testAccShiftAliasTrafficActionUpdateRouting(ctx, t, rName, "live", "2", 0.5),
+				Config:      testAccShiftAliasTrafficActionConfig_basic(rName),
+				ExpectError: regexache.MustCompile(`Lambda Alias Already Shifting Traffic`),
+			},
+		},
+	})
+}
+
+// testAccShiftAliasTrafficActionUpdateRouting routes weight of the alias traffic to additionalVersion, as if a traffic shift were in progress.
+func testAccShiftAliasTrafficActionUpdateRouting(ctx context.Context, t *testing.T, functionName, aliasName, additionalVersion string, weight float64) func() {
+	return func() {
+		conn := acctest.ProviderMeta(ctx, t).LambdaClient(ctx)
+
+		input := lambda.UpdateAliasInput{
+			FunctionName: aws.String(functionName),
+			Name:         aws.String(aliasName),
+			RoutingConfig: &awstypes.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]float64{
+					additionalVersion: weight,
+				},
+			},
+		}
+
+		if _, err := conn.UpdateAlias(ctx, &input); err != nil {
+			t.Fatalf("updating Lambda Alias (%s) routing configuration: %s", aliasName, err)
+		}
+	}
+}
+
+func testAccCheckShiftAliasTrafficActionVersion(v *lambda.GetAliasOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(v.FunctionVersion); got != expected {
+			return fmt.Errorf("Lambda Alias function version = %s, expected %s", got, expected)
+		}
+
+		if v.RoutingConfig != nil && len(v.RoutingConfig.AdditionalVersionWeights) > 0 {
+			return fmt.Errorf("Lambda Alias has additional version weights: %v", v.RoutingConfig.AdditionalVersionWeights)
+		}
+
+		return nil
+	}
+}
+
+func testAccShiftAliasTrafficActionConfig_base(rName, testData string, publish bool) string {
+	return acctest.ConfigCompose(
+		testAccInvokeActionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs22.x"
+  publish       = %[3]t
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "1"
+
+  lifecycle {
+    ignore_changes = [function_version, routing_config]
+  }
+}
+`, rName, testData, publish))
+}
+
+func testAccShiftAliasTrafficActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccShiftAliasTrafficActionConfig_base(rName, "v2", false),
+		`
+action "aws_lambda_shift_alias_traffic" "test" {
+  config {
+    function_name   = aws_lambda_function.test.function_name
+    alias_name      = aws_lambda_alias.test.name
+    step_percentage = 50
+    step_interval   = 5
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.code_sha256
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_shift_alias_traffic.test]
+    }
+  }
+}
+`)
+}
+
+func testAccShiftAliasTrafficActionConfig_alarms(rName string) string {
+	return acctest.ConfigCompose(
+		testAccShiftAliasTrafficActionConfig_base(rName, "v2", false),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 1
+  treat_missing_data  = "notBreaching"
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+    Resource     = "${aws_lambda_function.test.function_name}:${aws_lambda_alias.test.name}"
+  }
+}
+
+action "aws_lambda_shift_alias_traffic" "test" {
+  config {
+    function_name   = aws_lambda_function.test.function_name
+    alias_name      = aws_lambda_alias.test.name
+    alarm_names     = [aws_cloudwatch_metric_alarm.test.alarm_name]
+    step_percentage = 25
+    step_interval   = 15
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.code_sha256
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_shift_alias_traffic.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/authtoken"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_memorydb_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster to connect to.",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the token in RFC3339 format.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token to use as the password of the user.",
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the IAM-enabled user to connect as.",
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := fwflex.StringValueFromFramework(ctx, data.ClusterName)
+	query := url.Values{
+		"Action": []string{"connect"},
+		"User":   []string{fwflex.StringValueFromFramework(ctx, data.UserName)},
+	}
+	signingTime := time.Now()
+
+	token, err := authtoken.Build(ctx, e.Meta().CredentialsProvider(ctx), clusterName, "memorydb", e.Meta().Region(ctx), query, func(o *authtoken.Options) {
+		o.SigningTime = signingTime
+	})
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, clusterName)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(signingTime.Add(authtoken.DefaultExpiresIn))
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String      `tfsdk:"cluster_name"`
+	Expiration  timetypes.RFC3339 `tfsdk:"expiration"`
+	Token       types.String      `tfsdk:"token"`
+	UserName    types.String      `tfsdk:"user_name"`
+}
@@ -2182,6 +2182,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, wa
 		clusterStatusConfiguringIAMDatabaseAuth,
 		clusterStatusConfiguringEnhancedMonitoring,
 		clusterStatusCreating,
+		clusterStatusFailingOver,
 		clusterStatusMigrating,
 		clusterStatusModifying,
 		clusterStatusPreparingDataMigration,
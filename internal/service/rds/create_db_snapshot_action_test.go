@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDBInstanceDestroy(ctx, t),
+			testAccCheckCreateDBSnapshotActionDeleteSnapshot(ctx, t, rName),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, t, resourceName, &v),
+					testAccCheckCreateDBSnapshotActionSnapshotExists(ctx, t, rName, map[string]string{
+						names.AttrName: rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateDBSnapshotActionSnapshotExists verifies that the DB snapshot is available and has the specified tags.
+func testAccCheckCreateDBSnapshotActionSnapshotExists(ctx context.Context, t *testing.T, id string, tags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		if got, expected := aws.ToString(output.Status), "available"; got != expected {
+			return fmt.Errorf("RDS DB Snapshot %s has status %s, expected %s", id, got, expected)
+		}
+
+		for k, expected := range tags {
+			var found bool
+			for _, v := range output.TagList {
+				if aws.ToString(v.Key) == k {
+					if got := aws.ToString(v.Value); got != expected {
+						return fmt.Errorf("RDS DB Snapshot %s tag %s is %q, expected %q", id, k, got, expected)
+					}
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("RDS DB Snapshot %s has no tag %s", id, k)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCreateDBSnapshotActionDeleteSnapshot deletes the DB snapshot created by the action, which Terraform does not manage.
+func testAccCheckCreateDBSnapshotActionDeleteSnapshot(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		input := rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(id),
+		}
+		_, err := conn.DeleteDBSnapshot(ctx, &input)
+
+		if errs.IsA[*types.DBSnapshotNotFoundFault](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting RDS DB Snapshot (%s): %w", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_basic(rName), fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_db_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// failoverDBClusterPollInterval defines polling cadence for the failover DB cluster action.
+	failoverDBClusterPollInterval = 10 * time.Second
+
+	// Non-standard status values used while waiting for the writer to change.
+	failoverStatusPending  = "tf-writer-pending"
+	failoverStatusComplete = "tf-writer-changed"
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterActionModel]
+}
+
+type failoverDBClusterActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an RDS DB cluster, promoting a reader DB instance to the writer, and waits for the cluster to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the reader DB instance to promote to the writer. Defaults to a reader chosen by Amazon RDS",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the failover to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	target := fwflex.StringValueFromFramework(ctx, config.TargetDBInstanceIdentifier)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+	deadline := time.Now().Add(timeout)
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         id,
+		"target_db_instance_identifier": target,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	cluster, err := findDBClusterByID(ctx, conn, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Cluster",
+			fmt.Sprintf("Could not describe DB cluster %s: %s", id, err),
+		)
+		return
+	}
+
+	previousWriter := dbClusterWriter(cluster)
+	if target != "" && target == previousWriter {
+		resp.Diagnostics.AddError(
+			"Invalid Failover Target",
+			fmt.Sprintf("DB instance %s is already the writer of DB cluster %s", target, id),
+		)
+		return
+	}
+
+	if target != "" {
+		cb(ctx, "Failing over DB cluster %s from %s to %s...", id, previousWriter, target)
+	} else {
+		cb(ctx, "Failing over DB cluster %s from %s...", id, previousWriter)
+	}
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+	if target != "" {
+		input.TargetDBInstanceIdentifier = aws.String(target)
+	}
+
+	if _, err := conn.FailoverDBCluster(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Fail Over DB Cluster",
+			fmt.Sprintf("Could not fail over DB cluster %s: %s", id, err),
+		)
+		return
+	}
+
+	// Without a reader to promote, Amazon RDS restarts the writer in place.
+	if len(cluster.DBClusterMembers) > 1 {
+		cluster, err = waitDBClusterWriterChanged(ctx, conn, id, previousWriter, time.Until(deadline), cb)
+		if err != nil {
+			var timeoutErr *actionwait.TimeoutError
+			if errors.As(err, &timeoutErr) {
+				resp.Diagnostics.AddError(
+					"Timeout Waiting for DB Cluster Failover",
+					fmt.Sprintf("DB cluster %s writer did not change from %s within %s", id, previousWriter, timeout),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error Waiting for DB Cluster Failover",
+					fmt.Sprintf("Error while waiting for DB cluster %s writer to change from %s: %s", id, previousWriter, err),
+				)
+			}
+			return
+		}
+	}
+
+	writer := dbClusterWriter(cluster)
+	cb(ctx, "DB cluster %s writer is %s, waiting for the cluster to become available...", id, writer)
+
+	if _, err := waitDBClusterAvailable(ctx, conn, id, false, time.Until(deadline)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for DB Cluster",
+			fmt.Sprintf("Error while waiting for DB cluster %s to become available after failover: %s", id, err),
+		)
+		return
+	}
+
+	if _, err := waitDBClusterInstanceAvailable(ctx, conn, writer, time.Until(deadline)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for DB Cluster",
+			fmt.Sprintf("Error while waiting for DB cluster %s writer %s to become available after failover: %s", id, writer, err),
+		)
+		return
+	}
+
+	if target != "" && writer != target {
+		resp.Diagnostics.AddError(
+			"DB Cluster Failover Failed",
+			fmt.Sprintf("DB cluster %s failed over to %s instead of the requested target %s", id, writer, target),
+		)
+		return
+	}
+
+	cb(ctx, "DB cluster %s failed over successfully, writer is %s", id, writer)
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": id,
+		"writer":                writer,
+	})
+}
+
+// waitDBClusterWriterChanged waits for a DB cluster member other than previousWriter to become the writer, reporting the cluster status.
+func waitDBClusterWriterChanged(ctx context.Context, conn *rds.Client, id, previousWriter string, timeout time.Duration, cb fwactions.SendProgressFunc) (*awstypes.DBCluster, error) {
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBCluster], error) {
+		cluster, err := findDBClusterByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBCluster]{}, fmt.Errorf("describing DB cluster: %w", err)
+		}
+
+		if writer := dbClusterWriter(cluster); writer != "" && writer != previousWriter {
+			return actionwait.FetchResult[*awstypes.DBCluster]{Status: failoverStatusComplete, Value: cluster}, nil
+		}
+
+		return actionwait.FetchResult[*awstypes.DBCluster]{Status: failoverStatusPending, Value: cluster}, nil
+	}, actionwait.Options[*awstypes.DBCluster]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(failoverDBClusterPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{failoverStatusComplete},
+		TransitionalStates: []actionwait.Status{failoverStatusPending},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if cluster, ok := fr.Value.(*awstypes.DBCluster); ok && cluster != nil {
+				cb(ctx, "DB cluster %s is %s, waiting for the writer to change from %s...", id, aws.ToString(cluster.Status), previousWriter)
+			}
+		},
+	})
+
+	return result.Value, err
+}
+
+// dbClusterWriter returns the identifier of the DB cluster's writer DB instance, if any.
+func dbClusterWriter(cluster *awstypes.DBCluster) string {
+	for _, v := range cluster.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+	return ""
+}
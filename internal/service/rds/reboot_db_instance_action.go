@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_reboot_db_instance, name="Reboot DB Instance")
+func newRebootDBInstanceAction(context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBInstanceAction)(nil)
+)
+
+type rebootDBInstanceAction struct {
+	framework.ActionWithModel[rebootDBInstanceActionModel]
+}
+
+type rebootDBInstanceActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	ForceFailover        types.Bool   `tfsdk:"force_failover"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootDBInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an RDS DB instance, optionally failing over to its standby, and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to reboot",
+				Required:    true,
+			},
+			"force_failover": schema.BoolAttribute{
+				Description: "Whether to reboot with a failover to the standby. Only valid for Multi-AZ DB instances",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB instance to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootDBInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootDBInstanceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	forceFailover := fwflex.BoolValueFromFramework(ctx, config.ForceFailover)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS reboot DB instance action", map[string]any{
+		"db_instance_identifier": id,
+		"force_failover":         forceFailover,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	if forceFailover {
+		cb(ctx, "Rebooting DB instance %s with failover...", id)
+	} else {
+		cb(ctx, "Rebooting DB instance %s...", id)
+	}
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+
+	output, err := conn.RebootDBInstance(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot DB Instance",
+			fmt.Sprintf("Could not reboot DB instance %s: %s", id, err),
+		)
+		return
+	}
+
+	previousAZ := aws.ToString(output.DBInstance.AvailabilityZone)
+	cb(ctx, "DB instance %s is %s, waiting for it to become available...", id, aws.ToString(output.DBInstance.DBInstanceStatus))
+
+	instance, err := waitDBInstanceAvailable(ctx, conn, id, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for DB Instance",
+			fmt.Sprintf("Error while waiting for DB instance %s to become available after reboot: %s", id, err),
+		)
+		return
+	}
+
+	if az := aws.ToString(instance.AvailabilityZone); forceFailover && az != previousAZ {
+		cb(ctx, "DB instance %s failed over from %s to %s and is available", id, previousAZ, az)
+	} else {
+		cb(ctx, "DB instance %s rebooted successfully and is available", id)
+	}
+
+	tflog.Info(ctx, "RDS reboot DB instance action completed successfully", map[string]any{
+		"db_instance_identifier":   id,
+		names.AttrAvailabilityZone: aws.ToString(instance.AvailabilityZone),
+	})
+}
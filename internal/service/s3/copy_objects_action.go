@@ -0,0 +1,324 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// copyObjectMaxSize is the size of the largest object that can be copied in a single CopyObject request.
+	copyObjectMaxSize = 5 * 1024 * 1024 * 1024
+
+	// copyObjectsProgressSteps is the number of progress updates sent while copying objects.
+	copyObjectsProgressSteps = 10
+)
+
+// @Action(aws_s3_copy_objects, name="Copy Objects")
+func newCopyObjectsAction(context.Context) (action.ActionWithConfigure, error) {
+	return &copyObjectsAction{}, nil
+}
+
+var (
+	_ action.Action                   = (*copyObjectsAction)(nil)
+	_ action.ActionWithValidateConfig = (*copyObjectsAction)(nil)
+)
+
+type copyObjectsAction struct {
+	framework.ActionWithModel[copyObjectsActionModel]
+}
+
+type copyObjectsActionModel struct {
+	framework.WithRegionModel
+	DestinationBucket types.String `tfsdk:"destination_bucket"`
+	DestinationPrefix types.String `tfsdk:"destination_prefix"`
+	KMSKeyID          types.String `tfsdk:"kms_key_id"`
+	MaxConcurrency    types.Int64  `tfsdk:"max_concurrency"`
+	PreserveMetadata  types.Bool   `tfsdk:"preserve_metadata"`
+	PreserveTags      types.Bool   `tfsdk:"preserve_tags"`
+	SourceBucket      types.String `tfsdk:"source_bucket"`
+	SourcePrefix      types.String `tfsdk:"source_prefix"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *copyObjectsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copies every object under a prefix of an S3 bucket to a prefix of the same or another S3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"destination_bucket": schema.StringAttribute{
+				Description: "Name of the bucket to copy the objects to",
+				Required:    true,
+			},
+			"destination_prefix": schema.StringAttribute{
+				Description: "Prefix that replaces the source prefix in the keys of the copied objects (default: the source prefix)",
+				Optional:    true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Description: "ARN, ID or alias of the KMS key used to encrypt the copied objects with SSE-KMS",
+				Optional:    true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Description: "Maximum number of objects to copy at the same time (default: 10)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(100),
+				},
+			},
+			"preserve_metadata": schema.BoolAttribute{
+				Description: "Whether to copy the metadata of the source objects. If false, the copied objects have no user-defined metadata and a default content type (default: true)",
+				Optional:    true,
+			},
+			"preserve_tags": schema.BoolAttribute{
+				Description: "Whether to copy the tags of the source objects. If false, the copied objects have no tags (default: true)",
+				Optional:    true,
+			},
+			"source_bucket": schema.StringAttribute{
+				Description: "Name of the bucket to copy the objects from",
+				Required:    true,
+			},
+			"source_prefix": schema.StringAttribute{
+				Description: "Prefix of the keys of the objects to copy. If omitted, all objects in the bucket are copied",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for all objects to be copied (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *copyObjectsAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config copyObjectsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SourceBucket.IsUnknown() || config.DestinationBucket.IsUnknown() || config.SourcePrefix.IsUnknown() || config.DestinationPrefix.IsUnknown() {
+		return
+	}
+
+	if config.SourceBucket.ValueString() != config.DestinationBucket.ValueString() {
+		return
+	}
+
+	// destination_prefix defaults to source_prefix, and S3 rejects copying an object onto itself.
+	if config.DestinationPrefix.IsNull() || config.DestinationPrefix.ValueString() == config.SourcePrefix.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination_prefix"),
+			"Invalid Attribute Combination",
+			"`destination_prefix` must be set to a value other than `source_prefix` when `destination_bucket` is the same as `source_bucket`.",
+		)
+	}
+}
+
+func (a *copyObjectsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config copyObjectsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceBucket := fwflex.StringValueFromFramework(ctx, config.SourceBucket)
+	sourcePrefix := fwflex.StringValueFromFramework(ctx, config.SourcePrefix)
+	destinationBucket := fwflex.StringValueFromFramework(ctx, config.DestinationBucket)
+	destinationPrefix := sourcePrefix
+	if !config.DestinationPrefix.IsNull() {
+		destinationPrefix = config.DestinationPrefix.ValueString()
+	}
+	maxConcurrency := 10
+	if !config.MaxConcurrency.IsNull() {
+		maxConcurrency = int(config.MaxConcurrency.ValueInt64())
+	}
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	sourceConn := a.Meta().S3Client(ctx)
+	if isDirectoryBucket(sourceBucket) {
+		sourceConn = a.Meta().S3ExpressClient(ctx)
+	}
+	destinationConn := a.Meta().S3Client(ctx)
+	if isDirectoryBucket(destinationBucket) {
+		destinationConn = a.Meta().S3ExpressClient(ctx)
+	}
+
+	tflog.Info(ctx, "Starting S3 copy objects action", map[string]any{
+		"source_bucket":      sourceBucket,
+		"source_prefix":      sourcePrefix,
+		"destination_bucket": destinationBucket,
+		"destination_prefix": destinationPrefix,
+		"max_concurrency":    maxConcurrency,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cb(ctx, "Listing objects under s3://%s/%s...", sourceBucket, sourcePrefix)
+
+	input := s3.ListObjectsV2Input{
+		Bucket: aws.String(sourceBucket),
+	}
+	if sourcePrefix != "" {
+		input.Prefix = aws.String(sourcePrefix)
+	}
+
+	var objects []awstypes.Object
+	var totalBytes int64
+	var tooLarge []string
+	for object, err := range listObjects(ctx, sourceConn, &input) {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to List Objects",
+				fmt.Sprintf("Could not list objects under s3://%s/%s: %s", sourceBucket, sourcePrefix, err),
+			)
+			return
+		}
+
+		if aws.ToInt64(object.Size) > copyObjectMaxSize {
+			tooLarge = append(tooLarge, aws.ToString(object.Key))
+		}
+
+		objects = append(objects, object)
+		totalBytes += aws.ToInt64(object.Size)
+	}
+
+	// Objects larger than 5 GiB must be copied with a multipart upload, which this action does not do.
+	// Fail before copying anything rather than leave a partial copy behind.
+	if len(tooLarge) > 0 {
+		resp.Diagnostics.AddError(
+			"Objects Too Large to Copy",
+			fmt.Sprintf("%d objects under s3://%s/%s are larger than 5 GiB and cannot be copied in a single request: %s", len(tooLarge), sourceBucket, sourcePrefix, strings.Join(tooLarge, ", ")),
+		)
+		return
+	}
+
+	if len(objects) == 0 {
+		cb(ctx, "No objects found under s3://%s/%s, nothing to copy", sourceBucket, sourcePrefix)
+		return
+	}
+
+	cb(ctx, "Copying %d objects (%d bytes) from s3://%s/%s to s3://%s/%s...", len(objects), totalBytes, sourceBucket, sourcePrefix, destinationBucket, destinationPrefix)
+
+	metadataDirective, taggingDirective := awstypes.MetadataDirectiveCopy, awstypes.TaggingDirectiveCopy
+	if !config.PreserveMetadata.IsNull() && !config.PreserveMetadata.ValueBool() {
+		metadataDirective = awstypes.MetadataDirectiveReplace
+	}
+	if !config.PreserveTags.IsNull() && !config.PreserveTags.ValueBool() {
+		taggingDirective = awstypes.TaggingDirectiveReplace
+	}
+
+	// Stop starting new copies as soon as one fails.
+	copyCtx, cancelCopies := context.WithCancel(ctx)
+	defer cancelCopies()
+
+	var (
+		mutex          sync.Mutex
+		copiedObjects  int
+		copiedBytes    int64
+		progressStride = max(1, len(objects)/copyObjectsProgressSteps)
+		semaphore      = make(chan struct{}, maxConcurrency)
+		g              tfsync.Group
+	)
+	for _, object := range objects {
+		select {
+		case semaphore <- struct{}{}:
+		case <-copyCtx.Done():
+		}
+		if copyCtx.Err() != nil {
+			break
+		}
+
+		g.Go(copyCtx, func(ctx context.Context) error {
+			defer func() { <-semaphore }()
+
+			sourceKey := aws.ToString(object.Key)
+			destinationKey := destinationPrefix + strings.TrimPrefix(sourceKey, sourcePrefix)
+
+			input := s3.CopyObjectInput{
+				Bucket:            aws.String(destinationBucket),
+				CopySource:        aws.String(url.QueryEscape(sourceBucket + "/" + sourceKey)),
+				Key:               aws.String(destinationKey),
+				MetadataDirective: metadataDirective,
+				TaggingDirective:  taggingDirective,
+			}
+
+			if !config.KMSKeyID.IsNull() {
+				input.ServerSideEncryption = awstypes.ServerSideEncryptionAwsKms
+				input.SSEKMSKeyId = fwflex.StringFromFramework(ctx, config.KMSKeyID)
+			}
+
+			if _, err := destinationConn.CopyObject(ctx, &input); err != nil {
+				cancelCopies()
+				return fmt.Errorf("copying s3://%s/%s to s3://%s/%s: %w", sourceBucket, sourceKey, destinationBucket, destinationKey, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			copiedObjects++
+			copiedBytes += aws.ToInt64(object.Size)
+			if copiedObjects%progressStride == 0 && copiedObjects < len(objects) {
+				cb(ctx, "Copied %d of %d objects (%d of %d bytes)", copiedObjects, len(objects), copiedBytes, totalBytes)
+			}
+
+			return nil
+		})
+	}
+
+	err := g.Wait(copyCtx)
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Timeout Copying Objects",
+			fmt.Sprintf("Copied %d of %d objects from s3://%s/%s to s3://%s/%s before the timeout of %s", copiedObjects, len(objects), sourceBucket, sourcePrefix, destinationBucket, destinationPrefix, timeout),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Copy Objects",
+			fmt.Sprintf("Copied %d of %d objects from s3://%s/%s to s3://%s/%s before an error occurred: %s", copiedObjects, len(objects), sourceBucket, sourcePrefix, destinationBucket, destinationPrefix, err),
+		)
+		return
+	}
+
+	cb(ctx, "Copied %d objects (%d bytes) from s3://%s/%s to s3://%s/%s", copiedObjects, copiedBytes, sourceBucket, sourcePrefix, destinationBucket, destinationPrefix)
+
+	tflog.Info(ctx, "S3 copy objects action completed successfully", map[string]any{
+		"source_bucket":      sourceBucket,
+		"destination_bucket": destinationBucket,
+		"copied_objects":     copiedObjects,
+		"copied_bytes":       copiedBytes,
+	})
+}
@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3CopyObjectsAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 s3.HeadObjectOutput
+	rNameSource := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameDestination := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckBucketDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCopyObjectsActionConfig_basic(rNameSource, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCopyObjectsActionObjectExists(ctx, t, rNameDestination, "release/one.txt", &v1),
+					testAccCheckCopyObjectsActionObjectExists(ctx, t, rNameDestination, "release/nested/two.txt", &v2),
+					testAccCheckCopyObjectsActionObjectNotExists(ctx, t, rNameDestination, "other.txt"),
+					testAccCheckCopyObjectsActionObjectNotExists(ctx, t, rNameDestination, "staging/one.txt"),
+					testAccCheckCopyObjectsActionObjectMetadata(&v1, map[string]string{names.AttrKey: "one"}),
+					testAccCheckCopyObjectsActionObjectTags(ctx, t, rNameDestination, "release/one.txt", map[string]string{names.AttrKey: "one"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3CopyObjectsAction_kms(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3.HeadObjectOutput
+	rNameSource := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameDestination := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckBucketDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCopyObjectsActionConfig_kms(rNameSource, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCopyObjectsActionObjectExists(ctx, t, rNameDestination, "staging/one.txt", &v),
+					func(s *terraform.State) error {
+						if got, want := v.ServerSideEncryption, awstypes.ServerSideEncryptionAwsKms; got != want {
+							return fmt.Errorf("ServerSideEncryption = %s, want %s", got, want)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttrWith("aws_kms_key.test", names.AttrARN, func(value string) error {
+						if got := aws.ToString(v.SSEKMSKeyId); got != value {
+							return fmt.Errorf("SSEKMSKeyId = %s, want %s", got, value)
+						}
+						return nil
+					}),
+					testAccCheckCopyObjectsActionObjectMetadata(&v, map[string]string{}),
+					testAccCheckCopyObjectsActionObjectTags(ctx, t, rNameDestination, "staging/one.txt", map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCopyObjectsActionObjectExists(ctx context.Context, t *testing.T, bucket, key string, v *s3.HeadObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return fmt.Errorf("S3 Object (%s/%s) was not copied: %w", bucket, key, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCopyObjectsActionObjectNotExists(ctx context.Context, t *testing.T, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if retry.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s/%s) was unexpectedly copied", bucket, key)
+	}
+}
+
+func testAccCheckCopyObjectsActionObjectMetadata(v *s3.HeadObjectOutput, metadata map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !maps.Equal(v.Metadata, metadata) {
+			return fmt.Errorf("Metadata = %v, want %v", v.Metadata, metadata)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCopyObjectsActionObjectTags(ctx context.Context, t *testing.T, bucket, key string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).S3Client(ctx)
+
+		tags, err := tfs3.ObjectListTags(ctx, conn, bucket, key)
+
+		if err != nil {
+			return err
+		}
+
+		if got := tags.Map(); !maps.Equal(got, want) {
+			return fmt.Errorf("tags = %v, want %v", got, want)
+		}
+
+		return nil
+	}
+}
+
+func TestAccS3CopyObjectsAction_sameBucketSamePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCopyObjectsActionConfig_sameBucket(rName),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccCopyObjectsActionConfig_base(rNameSource, rNameDestination string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket        = %[2]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "one" {
+  bucket       = aws_s3_bucket.source.bucket
+  key          = "staging/one.txt"
+  content      = "one"
+  content_type = "text/plain"
+
+  metadata = {
+    key = "one"
+  }
+
+  tags = {
+    key = "one"
+  }
+}
+
+resource "aws_s3_object" "two" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "staging/nested/two.txt"
+  content = "two"
+}
+
+resource "aws_s3_object" "other" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "other.txt"
+  content = "other"
+}
+`, rNameSource, rNameDestination)
+}
+
+func testAccCopyObjectsActionConfig_basic(rNameSource, rNameDestination string) string {
+	return acctest.ConfigCompose(testAccCopyObjectsActionConfig_base(rNameSource, rNameDestination), `
+action "aws_s3_copy_objects" "test" {
+  config {
+    source_bucket      = aws_s3_bucket.source.bucket
+    source_prefix      = "staging/"
+    destination_bucket = aws_s3_bucket.destination.bucket
+    destination_prefix = "release/"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_s3_copy_objects.test]
+    }
+  }
+
+  depends_on = [aws_s3_object.one, aws_s3_object.two, aws_s3_object.other]
+}
+`)
+}
+
+func testAccCopyObjectsActionConfig_kms(rNameSource, rNameDestination string) string {
+	return acctest.ConfigCompose(testAccCopyObjectsActionConfig_base(rNameSource, rNameDestination), `
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+action "aws_s3_copy_objects" "test" {
+  config {
+    source_bucket      = aws_s3_bucket.source.bucket
+    source_prefix      = "staging/"
+    destination_bucket = aws_s3_bucket.destination.bucket
+    kms_key_id         = aws_kms_key.test.arn
+    preserve_metadata  = false
+    preserve_tags      = false
+    max_concurrency    = 1
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_s3_copy_objects.test]
+    }
+  }
+
+  depends_on = [aws_s3_object.one, aws_s3_object.two, aws_s3_object.other]
+}
+`)
+}
+
+func testAccCopyObjectsActionConfig_sameBucket(rName string) string {
+	return fmt.Sprintf(`
+action "aws_s3_copy_objects" "test" {
+  config {
+    source_bucket      = %[1]q
+    source_prefix      = "staging/"
+    destination_bucket = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_s3_copy_objects.test]
+    }
+  }
+}
+`, rName)
+}
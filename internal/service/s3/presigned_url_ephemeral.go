@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// presignedURLDefaultExpiresIn is the default duration for which a presigned URL is valid.
+	presignedURLDefaultExpiresIn = 15 * time.Minute
+
+	// presignedURLMaxExpiresIn is the longest duration for which a presigned URL can be valid.
+	presignedURLMaxExpiresIn = 7 * 24 * time.Hour
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigValidators = (*presignedURLEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig   = (*presignedURLEphemeralResource)(nil)
+)
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket that contains the object.",
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ChecksumAlgorithm](),
+				Optional:    true,
+				Description: "The algorithm of `checksum_value`. Only valid when `method` is `PUT`.",
+			},
+			"checksum_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "The base64-encoded checksum that the uploaded object must match. Only valid when `method` is `PUT`.",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the presigned URL in RFC3339 format.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(presignedURLMaxExpiresIn/time.Second)),
+				},
+				Description: "The duration, in seconds, for which the presigned URL will remain valid. Value can range from 1 to 604800 seconds. Default is 900 seconds (15 minutes).",
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the object.",
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional:    true,
+				Description: "The ARN, ID or alias of the KMS key used to encrypt the uploaded object. Only valid when `method` is `PUT`.",
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodHead, http.MethodPut),
+				},
+				Description: "The HTTP method of the request that the presigned URL is used for. Valid values are `GET`, `HEAD` and `PUT`. Default is `GET`.",
+			},
+			"server_side_encryption": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ServerSideEncryption](),
+				Optional:    true,
+				Description: "The server-side encryption algorithm used to encrypt the uploaded object. Only valid when `method` is `PUT`.",
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The headers, other than `Host`, that must be sent with the request, keyed by header name.",
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL.",
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) ConfigValidators(context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.RequiredTogether(
+			path.MatchRoot("checksum_algorithm"),
+			path.MatchRoot("checksum_value"),
+		),
+	}
+}
+
+func (e *presignedURLEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	var data presignedURLEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Method.IsUnknown() {
+		return
+	}
+
+	method := presignedURLMethod(data.Method)
+	if method == http.MethodPut {
+		return
+	}
+
+	for _, v := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"checksum_algorithm", data.ChecksumAlgorithm},
+		{"checksum_value", data.ChecksumValue},
+		{names.AttrKMSKeyID, data.KMSKeyID},
+		{"server_side_encryption", data.ServerSideEncryption},
+	} {
+		if !v.value.IsNull() && !v.value.IsUnknown() {
+			response.Diagnostics.AddAttributeError(
+				path.Root(v.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("`%s` must not be set when `method` is %q.", v.name, method),
+			)
+		}
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, key := fwflex.StringValueFromFramework(ctx, data.Bucket), fwflex.StringValueFromFramework(ctx, data.Key)
+	conn := e.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	expiresIn := presignedURLDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+	signingTime := time.Now()
+
+	presignClient := s3.NewPresignClient(conn, s3.WithPresignExpires(expiresIn))
+	var output *v4.PresignedHTTPRequest
+	var err error
+
+	switch method := presignedURLMethod(data.Method); method {
+	case http.MethodGet:
+		output, err = presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	case http.MethodHead:
+		output, err = presignClient.PresignHeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	case http.MethodPut:
+		input := s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		// The checksum value must be supplied, otherwise the SDK would compute the checksum of an empty body.
+		if checksum := fwflex.StringFromFramework(ctx, data.ChecksumValue); checksum != nil {
+			switch data.ChecksumAlgorithm.ValueEnum() {
+			case awstypes.ChecksumAlgorithmCrc32:
+				input.ChecksumCRC32 = checksum
+			case awstypes.ChecksumAlgorithmCrc32c:
+				input.ChecksumCRC32C = checksum
+			case awstypes.ChecksumAlgorithmCrc64nvme:
+				input.ChecksumCRC64NVME = checksum
+			case awstypes.ChecksumAlgorithmMd5:
+				input.ChecksumMD5 = checksum
+			case awstypes.ChecksumAlgorithmSha1:
+				input.ChecksumSHA1 = checksum
+			case awstypes.ChecksumAlgorithmSha256:
+				input.ChecksumSHA256 = checksum
+			case awstypes.ChecksumAlgorithmSha512:
+				input.ChecksumSHA512 = checksum
+			case awstypes.ChecksumAlgorithmXxhash3:
+				input.ChecksumXXHASH3 = checksum
+			case awstypes.ChecksumAlgorithmXxhash64:
+				input.ChecksumXXHASH64 = checksum
+			case awstypes.ChecksumAlgorithmXxhash128:
+				input.ChecksumXXHASH128 = checksum
+			}
+		}
+
+		if !data.ServerSideEncryption.IsNull() {
+			input.ServerSideEncryption = data.ServerSideEncryption.ValueEnum()
+		}
+		input.SSEKMSKeyId = fwflex.StringFromFramework(ctx, data.KMSKeyID)
+
+		output, err = presignClient.PresignPutObject(ctx, &input)
+	default:
+		err = fmt.Errorf("unsupported method: %s", method)
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, bucket)
+		return
+	}
+
+	signedHeaders := make(map[string]string)
+	for name, values := range output.SignedHeader {
+		if strings.EqualFold(name, "Host") {
+			continue
+		}
+		signedHeaders[name] = strings.Join(values, ",")
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(signingTime.Add(expiresIn))
+	data.SignedHeaders = fwflex.FlattenFrameworkStringValueMapOfString(ctx, signedHeaders)
+	data.URL = types.StringValue(output.URL)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+// presignedURLMethod returns the configured HTTP method, defaulting to GET.
+func presignedURLMethod(v types.String) string {
+	if v.IsNull() {
+		return http.MethodGet
+	}
+
+	return v.ValueString()
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket               types.String                                      `tfsdk:"bucket"`
+	ChecksumAlgorithm    fwtypes.StringEnum[awstypes.ChecksumAlgorithm]    `tfsdk:"checksum_algorithm"`
+	ChecksumValue        types.String                                      `tfsdk:"checksum_value"`
+	Expiration           timetypes.RFC3339                                 `tfsdk:"expiration"`
+	ExpiresIn            types.Int64                                       `tfsdk:"expires_in"`
+	Key                  types.String                                      `tfsdk:"key"`
+	KMSKeyID             types.String                                      `tfsdk:"kms_key_id"`
+	Method               types.String                                      `tfsdk:"method"`
+	ServerSideEncryption fwtypes.StringEnum[awstypes.ServerSideEncryption] `tfsdk:"server_side_encryption"`
+	SignedHeaders        fwtypes.MapOfString                               `tfsdk:"signed_headers"`
+	URL                  types.String                                      `tfsdk:"url"`
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	rotateSecretPollInterval = 5 * time.Second
+)
+
+// Rotation statuses derived from the staging labels attached to the version created by the rotation.
+const (
+	// The rotation function has not yet created the new version.
+	rotationStatusCreating = "CREATING"
+	// The new version exists and is labeled AWSPENDING.
+	rotationStatusPending = "PENDING"
+	// The new version has been promoted to AWSCURRENT.
+	rotationStatusCurrent = "CURRENT"
+	// The new version exists with neither label, or was removed after it was created.
+	rotationStatusAbandoned = "ABANDONED"
+)
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(context.Context) (action.ActionWithConfigure, error) {
+	return &rotateSecretAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	SecretID types.String `tfsdk:"secret_id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Secrets Manager secret immediately and waits for the new secret version to become current.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description: "ARN or name of the secret to rotate",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := fwflex.StringValueFromFramework(ctx, config.SecretID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 10*time.Minute)
+
+	tflog.Info(ctx, "Starting Secrets Manager rotate secret action", map[string]any{
+		"secret_id":       secretID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	cb(ctx, "Rotating Secrets Manager secret %s...", secretID)
+
+	input := secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(true),
+		SecretId:          aws.String(secretID),
+	}
+
+	output, err := conn.RotateSecret(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Secret",
+			fmt.Sprintf("Could not rotate Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	versionID := aws.ToString(output.VersionId)
+
+	cb(ctx, "Rotation of Secrets Manager secret %s started, new version %s", secretID, versionID)
+
+	var lastStatus actionwait.Status
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*secretsmanager.DescribeSecretOutput], error) {
+		secret, err := findSecretByID(ctx, conn, secretID)
+		if err != nil {
+			return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{}, err
+		}
+
+		status := secretRotationStatus(secret.VersionIdsToStages[versionID], lastStatus)
+		if status != lastStatus {
+			switch status {
+			case rotationStatusCreating:
+				cb(ctx, "Waiting for the rotation function to create version %s...", versionID)
+			case rotationStatusPending:
+				cb(ctx, "Version %s created with label %s, waiting for it to be set and tested...", versionID, secretVersionStagePending)
+			case rotationStatusCurrent:
+				cb(ctx, "Version %s promoted from %s to %s", versionID, secretVersionStagePending, secretVersionStageCurrent)
+			}
+			lastStatus = status
+		}
+
+		return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{Status: status, Value: secret}, nil
+	}, actionwait.Options[*secretsmanager.DescribeSecretOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(rotateSecretPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{rotationStatusCurrent},
+		TransitionalStates: []actionwait.Status{
+			rotationStatusCreating,
+			rotationStatusPending,
+		},
+		FailureStates: []actionwait.Status{rotationStatusAbandoned},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Rotation of Secrets Manager secret %s is still %s, continuing to wait for completion...", secretID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Secret Rotation to Complete",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s did not complete within %s (status: %s). Check the logs of the rotation function for errors.", secretID, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Secret Rotation Failed",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s failed, version %s was not promoted to %s. Check the logs of the rotation function for errors.", secretID, versionID, secretVersionStageCurrent),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Secret Rotation Status",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s entered unexpected status: %s", secretID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Secret Rotation to Complete",
+				fmt.Sprintf("Error while waiting for rotation of Secrets Manager secret %s to complete: %s", secretID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Secrets Manager secret %s rotated successfully", secretID)
+
+	tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully", map[string]any{
+		"secret_id":  secretID,
+		"version_id": versionID,
+	})
+}
+
+// secretRotationStatus returns the status of a rotation from the staging labels of the version being rotated to.
+func secretRotationStatus(stages []string, previous actionwait.Status) actionwait.Status {
+	switch {
+	case slices.Contains(stages, secretVersionStageCurrent):
+		return rotationStatusCurrent
+	case slices.Contains(stages, secretVersionStagePending):
+		return rotationStatusPending
+	case len(stages) > 0:
+		return rotationStatusAbandoned
+	case previous == rotationStatusPending:
+		// The version was created and then removed.
+		return rotationStatusAbandoned
+	default:
+		return rotationStatusCreating
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerRotateSecretAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var secret secretsmanager.DescribeSecretOutput
+	resourceName := "aws_secretsmanager_secret.test"
+	versionResourceName := "aws_secretsmanager_secret_version.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretExists(ctx, t, resourceName, &secret),
+					testAccCheckRotateSecretActionRotated(ctx, t, resourceName, versionResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_timeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_timeout(rName),
+				ExpectError: regexache.MustCompile(`Timeout Waiting for Secret Rotation to Complete`),
+			},
+		},
+	})
+}
+
+// testAccCheckRotateSecretActionRotated checks that the version created by Terraform is no longer the current version.
+func testAccCheckRotateSecretActionRotated(ctx context.Context, t *testing.T, n, versionResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		vrs, ok := s.RootModule().Resources[versionResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", versionResourceName)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SecretsManagerClient(ctx)
+
+		output, err := tfsecretsmanager.FindSecretByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		originalVersionID := vrs.Primary.Attributes["version_id"]
+		for versionID, stages := range output.VersionIdsToStages {
+			if slices.Contains(stages, "AWSCURRENT") {
+				if versionID == originalVersionID {
+					return fmt.Errorf("Secrets Manager Secret %s was not rotated, version %s is still AWSCURRENT", rs.Primary.ID, versionID)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Secrets Manager Secret %s has no AWSCURRENT version", rs.Primary.ID)
+	}
+}
+
+func testAccRotateSecretActionConfig_base(rName, filename, handler string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_iam_role_policy" "test" {
+  name = "%[1]s-secretsmanager"
+  role = aws_iam_role.iam_for_lambda.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetRandomPassword",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:PutSecretValue",
+        "secretsmanager:UpdateSecretVersionStage",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = %[2]q
+  function_name = %[1]q
+  handler       = %[3]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  runtime       = "nodejs24.x"
+  timeout       = 30
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager1"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret.test.id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+  rotate_immediately  = false
+
+  rotation_rules {
+    automatically_after_days = 7
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_lambda_permission.test, aws_secretsmanager_secret_version.test]
+}
+`, rName, filename, handler))
+}
+
+func testAccRotateSecretActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRotateSecretActionConfig_base(rName, "test-fixtures/rotate_secret.zip", "rotate_secret.handler"),
+		`
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_rotation.test.secret_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_secretsmanager_secret_rotation.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`)
+}
+
+func testAccRotateSecretActionConfig_timeout(rName string) string {
+	return acctest.ConfigCompose(
+		// Not a real rotation function.
+		testAccRotateSecretActionConfig_base(rName, "test-fixtures/lambdatest.zip", "exports.example"),
+		`
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_rotation.test.secret_id
+    timeout   = 30
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_secretsmanager_secret_rotation.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`)
+}
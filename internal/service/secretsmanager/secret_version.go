@@ -31,6 +31,7 @@ import (
 
 const (
 	secretVersionStageCurrent  = "AWSCURRENT"
+	secretVersionStagePending  = "AWSPENDING"
 	secretVersionStagePrevious = "AWSPREVIOUS"
 )
 
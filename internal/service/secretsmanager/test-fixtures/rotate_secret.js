@@ -0,0 +1,64 @@
+/**
+ * Copyright IBM Corp. 2014, 2026
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+// Minimal rotation function that rotates a plaintext secret to a random value.
+const {
+    SecretsManagerClient,
+    DescribeSecretCommand,
+    GetRandomPasswordCommand,
+    GetSecretValueCommand,
+    PutSecretValueCommand,
+    UpdateSecretVersionStageCommand,
+} = require("@aws-sdk/client-secrets-manager");
+
+const client = new SecretsManagerClient();
+
+exports.handler = async (event) => {
+    const { SecretId: secretId, ClientRequestToken: token, Step: step } = event;
+
+    switch (step) {
+        case "createSecret": {
+            try {
+                await client.send(new GetSecretValueCommand({ SecretId: secretId, VersionId: token, VersionStage: "AWSPENDING" }));
+            } catch (e) {
+                if (e.name !== "ResourceNotFoundException") {
+                    throw e;
+                }
+                const password = await client.send(new GetRandomPasswordCommand({ ExcludePunctuation: true }));
+                await client.send(new PutSecretValueCommand({
+                    SecretId: secretId,
+                    ClientRequestToken: token,
+                    SecretString: password.RandomPassword,
+                    VersionStages: ["AWSPENDING"],
+                }));
+            }
+            break;
+        }
+        case "setSecret":
+        case "testSecret":
+            break;
+        case "finishSecret": {
+            const secret = await client.send(new DescribeSecretCommand({ SecretId: secretId }));
+            let currentVersion;
+            for (const [version, stages] of Object.entries(secret.VersionIdsToStages)) {
+                if (stages.includes("AWSCURRENT")) {
+                    currentVersion = version;
+                }
+            }
+            if (currentVersion === token) {
+                break;
+            }
+            await client.send(new UpdateSecretVersionStageCommand({
+                SecretId: secretId,
+                VersionStage: "AWSCURRENT",
+                MoveToVersionId: token,
+                RemoveFromVersionId: currentVersion,
+            }));
+            break;
+        }
+        default:
+            throw new Error(`Unknown step ${step}`);
+    }
+};
@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sendCommandPollInterval defines polling cadence for the send command action.
+const sendCommandPollInterval = 5 * time.Second
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*sendCommandAction)(nil)
+	_ action.ActionWithConfigValidators = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                            `tfsdk:"comment"`
+	DocumentName    types.String                                            `tfsdk:"document_name"`
+	DocumentVersion types.String                                            `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                                    `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                            `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                            `tfsdk:"max_errors"`
+	Parameters      types.Map                                               `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[sendCommandTargetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                             `tfsdk:"timeout"`
+}
+
+type sendCommandTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed instances and waits for every command invocation to complete. The action fails if the command fails on any target instance.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command, such as a brief description of what the command should do",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the SSM document to run, for example AWS-RunShellScript",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "SSM document version to run. Can be a specific version number, $DEFAULT or $LATEST",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "IDs of the managed instances to run the command on",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number of instances that are allowed to run the command at the same time, as an absolute number or a percentage",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number of errors allowed before the system stops sending the command to additional targets, as an absolute number or a percentage",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to complete on all targets (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sendCommandTargetModel](ctx),
+				Description: "Targets the command by tag or resource group instead of by instance ID",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, for example tag:Environment or InstanceIds",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "Target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("targets"),
+		),
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !config.Targets.IsNull() {
+		resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Sending SSM command %s...", documentName)
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+	cb(ctx, "SSM command %s sent, waiting for command invocations to complete...", commandID)
+
+	// Report each instance's status as it changes.
+	statuses := make(map[string]awstypes.CommandInvocationStatus)
+	reportInvocations := func(invocations []awstypes.CommandInvocation) {
+		for _, invocation := range invocations {
+			instanceID := aws.ToString(invocation.InstanceId)
+			if statuses[instanceID] == invocation.Status {
+				continue
+			}
+			statuses[instanceID] = invocation.Status
+			cb(ctx, "SSM command %s on instance %s is in status '%s'", commandID, instanceID, invocation.Status)
+		}
+	}
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("reading command: %w", err)
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("listing command invocations: %w", err)
+		}
+		reportInvocations(invocations)
+
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		// The command is complete once every invocation has finished, whatever the outcome.
+		// Failed invocations are reported individually below.
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+			actionwait.Status(awstypes.CommandStatusCancelled),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "SSM command %s is currently in status '%s', continuing to wait for completion...", commandID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command to Complete",
+				fmt.Sprintf("SSM command %s did not complete within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command Status",
+				fmt.Sprintf("SSM command %s entered unexpected status: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command to Complete",
+				fmt.Sprintf("Error while waiting for SSM command %s to complete: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	// Read the final status of every invocation.
+	invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Command Invocations",
+			fmt.Sprintf("Could not list invocations of SSM command %s: %s", commandID, err),
+		)
+		return
+	}
+	reportInvocations(invocations)
+
+	if len(invocations) == 0 {
+		resp.Diagnostics.AddError(
+			"No Command Targets",
+			fmt.Sprintf("SSM command %s did not run on any instance", commandID),
+		)
+		return
+	}
+
+	failures := make(map[string]string)
+	for _, invocation := range invocations {
+		if invocation.Status != awstypes.CommandInvocationStatusSuccess {
+			failures[aws.ToString(invocation.InstanceId)] = fmt.Sprintf("%s (%s)", invocation.Status, aws.ToString(invocation.StatusDetails))
+		}
+	}
+
+	if len(failures) > 0 {
+		var details []string
+		for _, instanceID := range slices.Sorted(maps.Keys(failures)) {
+			details = append(details, fmt.Sprintf("%s: %s", instanceID, failures[instanceID]))
+		}
+
+		resp.Diagnostics.AddError(
+			"Command Failed",
+			fmt.Sprintf("SSM command %s failed on %d of %d instances:\n%s", commandID, len(failures), len(invocations), strings.Join(details, "\n")),
+		)
+		return
+	}
+
+	cb(ctx, "SSM command %s completed successfully on %d instances", commandID, len(invocations))
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id": commandID,
+		"instances":  len(invocations),
+	})
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
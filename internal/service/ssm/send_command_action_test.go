@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionSucceeded(ctx, t, resourceName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_targets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionSucceeded(ctx, t, resourceName, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendCommandActionRegistrationSleep() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+		time.Sleep(1 * time.Minute)
+		return nil
+	}
+}
+
+// testAccCheckSendCommandActionSucceeded verifies that the command with the specified comment ran successfully on the instance.
+func testAccCheckSendCommandActionSucceeded(ctx context.Context, t *testing.T, n, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := ssm.ListCommandInvocationsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+		pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.CommandInvocations {
+				if aws.ToString(v.Comment) != comment {
+					continue
+				}
+
+				if v.Status != awstypes.CommandInvocationStatusSuccess {
+					return fmt.Errorf("SSM command %s on instance %s has status %s, expected %s", aws.ToString(v.CommandId), rs.Primary.ID, v.Status, awstypes.CommandInvocationStatusSuccess)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("SSM command %q was not run on instance %s", comment, rs.Primary.ID)
+	}
+}
+
+func testAccSendCommandActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    comment       = %[1]q
+    instance_ids  = [aws_instance.test.id]
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name   = "AWS-RunShellScript"
+    comment         = %[1]q
+    max_concurrency = "50%%"
+    max_errors      = "0"
+    timeout         = 600
+
+    targets {
+      key    = "tag:Name"
+      values = [aws_instance.test.tags["Name"]]
+    }
+
+    parameters = {
+      commands = ["uptime"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName))
+}
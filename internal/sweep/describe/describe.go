@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package describe
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CreationTimeAttributes are the names of the attributes that commonly hold a resource's creation time.
+var CreationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"launch_time",
+}
+
+// Description describes a resource that a sweeper deletes.
+type Description struct {
+	ID        string
+	Name      string
+	Tags      map[string]string
+	CreatedAt time.Time // Zero if unknown.
+}
+
+// Describer is implemented by sweepables that can describe the resource they delete.
+type Describer interface {
+	// Describe returns a description of the resource, or nil if the resource no longer exists.
+	Describe(ctx context.Context) (*Description, error)
+}
+
+// ParseTime parses a creation time attribute value.
+func ParseTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339, "2006-01-02T15:04:05Z0700", time.DateTime} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// Filter selects the resources that sweepers delete. The zero value selects all resources.
+type Filter struct {
+	// MinAge selects resources created at least this long ago.
+	// Resources with an unknown creation time are not selected.
+	MinAge time.Duration
+
+	// NamePrefixes selects resources whose name, or ID if the resource has no name, starts with any of the prefixes.
+	NamePrefixes []string
+
+	// Tags selects resources with all of the tags. A nil value matches any tag value.
+	Tags map[string]*string
+}
+
+// IsEmpty returns whether the filter selects all resources.
+func (f Filter) IsEmpty() bool {
+	return f.MinAge == 0 && len(f.NamePrefixes) == 0 && len(f.Tags) == 0
+}
+
+// Match returns whether the filter selects the described resource.
+func (f Filter) Match(d *Description, now time.Time) bool {
+	if f.MinAge > 0 && (d.CreatedAt.IsZero() || now.Sub(d.CreatedAt) < f.MinAge) {
+		return false
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := d.Name
+		if name == "" {
+			name = d.ID
+		}
+
+		if !slices.ContainsFunc(f.NamePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		}) {
+			return false
+		}
+	}
+
+	for k, v := range f.Tags {
+		value, ok := d.Tags[k]
+		if !ok || (v != nil && value != *v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseTagFilter parses a comma-separated list of "key" or "key=value" tag filters.
+func ParseTagFilter(s string) (map[string]*string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tags := make(map[string]*string)
+	for v := range strings.SplitSeq(s, ",") {
+		key, value, hasValue := strings.Cut(v, "=")
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): key must not be empty", v)
+		}
+
+		if hasValue {
+			tags[key] = &value
+		} else {
+			tags[key] = nil
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    string
+		expected time.Time
+		ok       bool
+	}{
+		"RFC3339": {
+			value:    "2024-03-01T12:00:00Z",
+			expected: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		"RFC3339 nanoseconds": {
+			value:    "2024-03-01T12:00:00.123Z",
+			expected: time.Date(2024, 3, 1, 12, 0, 0, 123000000, time.UTC),
+			ok:       true,
+		},
+		"numeric zone": {
+			value:    "2024-03-01T12:00:00+0000",
+			expected: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		"empty": {},
+		"invalid": {
+			value: "yesterday",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ParseTime(testCase.value)
+
+			if ok != testCase.ok {
+				t.Fatalf("unexpected ok. Expected: %t, got: %t", testCase.ok, ok)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("unexpected time. Expected: %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	team, ci := "team", "ci"
+	d := &Description{
+		ID:        "vpc-12345678",
+		Name:      "tf-acc-test-1234",
+		Tags:      map[string]string{"Owner": team, "Ephemeral": ""},
+		CreatedAt: now.Add(-48 * time.Hour),
+	}
+
+	testCases := map[string]struct {
+		filter      Filter
+		description *Description
+		expected    bool
+	}{
+		"empty": {
+			description: d,
+			expected:    true,
+		},
+		"min age match": {
+			filter:      Filter{MinAge: 24 * time.Hour},
+			description: d,
+			expected:    true,
+		},
+		"min age too new": {
+			filter:      Filter{MinAge: 72 * time.Hour},
+			description: d,
+		},
+		"min age unknown creation time": {
+			filter:      Filter{MinAge: time.Hour},
+			description: &Description{ID: "vpc-12345678"},
+		},
+		"name prefix match": {
+			filter:      Filter{NamePrefixes: []string{"other-", "tf-acc-test-"}},
+			description: d,
+			expected:    true,
+		},
+		"name prefix no match": {
+			filter:      Filter{NamePrefixes: []string{"other-"}},
+			description: d,
+		},
+		"name prefix ID match": {
+			filter:      Filter{NamePrefixes: []string{"vpc-"}},
+			description: &Description{ID: "vpc-12345678"},
+			expected:    true,
+		},
+		"tag key and value match": {
+			filter:      Filter{Tags: map[string]*string{"Owner": &team, "Ephemeral": nil}},
+			description: d,
+			expected:    true,
+		},
+		"tag value no match": {
+			filter:      Filter{Tags: map[string]*string{"Owner": &ci}},
+			description: d,
+		},
+		"tag key no match": {
+			filter:      Filter{Tags: map[string]*string{"Environment": nil}},
+			description: d,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := testCase.filter.Match(testCase.description, now), testCase.expected; got != expected {
+				t.Errorf("unexpected match. Expected: %t, got: %t", expected, got)
+			}
+		})
+	}
+}
+
+func TestParseTagFilter(t *testing.T) {
+	t.Parallel()
+
+	value, empty := "team", ""
+
+	testCases := map[string]struct {
+		value         string
+		expected      map[string]*string
+		expectedError bool
+	}{
+		"empty": {},
+		"keys and values": {
+			value: "Owner=team,Ephemeral,Environment=",
+			expected: map[string]*string{
+				"Owner":       &value,
+				"Ephemeral":   nil,
+				"Environment": &empty,
+			},
+		},
+		"empty key": {
+			value:         "=team",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTagFilter(testCase.value)
+
+			if got, expected := err != nil, testCase.expectedError; got != expected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/describe"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// readOnlyOperationPrefixes are the prefixes of the names of AWS API operations that don't modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// readOnlyAPIOption fails any AWS API operation that may modify resources.
+// It guards against sweepers that call delete operations directly rather than using SweepOrchestrator.
+func readOnlyAPIOption(stack *middleware.Stack) error {
+	operation := stack.ID()
+	if slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operation, prefix)
+	}) {
+		return nil
+	}
+
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweepDryRun", func(context.Context, middleware.InitializeInput, middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("operation (%s) not allowed in sweeper dry-run mode", operation)
+	}), middleware.Before)
+}
+
+// ReportEntry is an entry in the sweeper dry-run report.
+type ReportEntry struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id,omitempty"`
+	Region     string            `json:"region"`
+	Name       string            `json:"name,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	CreatedAt  *time.Time        `json:"created_at,omitempty"`
+	AgeSeconds *int64            `json:"age_seconds,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// sweepRun holds the state of a sweeper run.
+// In dry-run mode sweepers run one at a time, so the current sweeper and region identify the resources described.
+var sweepRun struct {
+	mutex   sync.Mutex
+	enabled bool
+	filter  describe.Filter
+	sweeper string
+	region  string
+	report  []ReportEntry
+}
+
+func configureRun(enabled bool, filter describe.Filter) {
+	sweepRun.mutex.Lock()
+	defer sweepRun.mutex.Unlock()
+
+	sweepRun.enabled = enabled
+	sweepRun.filter = filter
+	sweepRun.report = nil
+}
+
+// setCurrentSweeper is called each time a sweeper runs in a region.
+// A failed sweeper is retried, so the report entries of any previous run of the sweeper in the region are discarded.
+func setCurrentSweeper(name, region string) {
+	sweepRun.mutex.Lock()
+	defer sweepRun.mutex.Unlock()
+
+	sweepRun.sweeper, sweepRun.region = name, region
+	sweepRun.report = slices.DeleteFunc(sweepRun.report, func(entry ReportEntry) bool {
+		return entry.Type == name && entry.Region == region
+	})
+}
+
+func isDryRun() bool {
+	sweepRun.mutex.Lock()
+	defer sweepRun.mutex.Unlock()
+
+	return sweepRun.enabled
+}
+
+func runFilter() describe.Filter {
+	sweepRun.mutex.Lock()
+	defer sweepRun.mutex.Unlock()
+
+	return sweepRun.filter
+}
+
+func addReportEntry(entry ReportEntry) {
+	sweepRun.mutex.Lock()
+	defer sweepRun.mutex.Unlock()
+
+	if entry.Type == "" {
+		entry.Type = sweepRun.sweeper
+	}
+	if entry.Region == "" {
+		entry.Region = sweepRun.region
+	}
+	sweepRun.report = append(sweepRun.report, entry)
+}
+
+func newReportEntry(d *describe.Description, now time.Time) ReportEntry {
+	entry := ReportEntry{
+		ID:   d.ID,
+		Name: d.Name,
+		Tags: d.Tags,
+	}
+
+	if !d.CreatedAt.IsZero() {
+		createdAt := d.CreatedAt.UTC()
+		age := int64(now.Sub(createdAt).Seconds())
+		entry.CreatedAt = &createdAt
+		entry.AgeSeconds = &age
+	}
+
+	return entry
+}
+
+// writeReport writes the dry-run report as JSON.
+func writeReport(w io.Writer) error {
+	sweepRun.mutex.Lock()
+	defer sweepRun.mutex.Unlock()
+
+	report := sweepRun.report
+	if report == nil {
+		report = []ReportEntry{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// selectSweepables describes each sweepable and returns those selected by the run's filter.
+// In dry-run mode each selected sweepable is added to the report and none are returned.
+func selectSweepables(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	dryRun, filter := isDryRun(), runFilter()
+	now := time.Now()
+
+	var mutex sync.Mutex
+	var selected []Sweepable
+	var g tfsync.Group
+
+	for _, sweepable := range sweepables {
+		describer, ok := sweepable.(describe.Describer)
+		if !ok {
+			if dryRun {
+				addReportEntry(ReportEntry{Error: fmt.Sprintf("%T cannot be described", sweepable)})
+			} else {
+				tflog.Warn(ctx, "Skipping resource that cannot be filtered", map[string]any{
+					"type": fmt.Sprintf("%T", sweepable),
+				})
+			}
+			continue
+		}
+
+		g.Go(ctx, func(ctx context.Context) error {
+			d, err := describer.Describe(ctx)
+			if err != nil {
+				if dryRun {
+					addReportEntry(ReportEntry{Error: err.Error()})
+					return nil
+				}
+				return err
+			}
+
+			if d == nil || !filter.Match(d, now) {
+				return nil
+			}
+
+			if dryRun {
+				addReportEntry(newReportEntry(d, now))
+				return nil
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			selected = append(selected, sweepable)
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRunSweepers_dryRunRetry(t *testing.T) { //nolint:paralleltest // Sweepers and the dry-run report are global
+	const name = "test_dry_run_retry"
+
+	var runs int
+	AddTestSweepers(name, &resource.Sweeper{
+		Name: name,
+		F: func(string) error {
+			runs++
+
+			addReportEntry(ReportEntry{ID: "test-1"})
+			addReportEntry(ReportEntry{ID: "test-2"})
+
+			if runs == 1 {
+				return errors.New("test error")
+			}
+
+			return nil
+		},
+	})
+	t.Cleanup(func() {
+		delete(sweepers, name)
+	})
+
+	var report bytes.Buffer
+	err := RunSweepers(t.Context(), []string{"us-west-2"}, name, RunOptions{ //lintignore:AWSAT003
+		DryRun: true,
+		Report: &report,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := runs, 2; got != want {
+		t.Errorf("runs = %d, want %d", got, want)
+	}
+
+	var got []ReportEntry
+	if err := json.Unmarshal(report.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshaling report: %s", err)
+	}
+
+	want := []ReportEntry{
+		{Type: name, ID: "test-1", Region: "us-west-2"}, //lintignore:AWSAT003
+		{Type: name, ID: "test-2", Region: "us-west-2"}, //lintignore:AWSAT003
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/describe"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -47,6 +50,92 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	return sr.withState(ctx, func(ctx context.Context, resource fwresource.Resource, state tfsdk.State) error {
+		tflog.Info(ctx, "Sweeping resource")
+
+		return deleteResource(ctx, state, resource)
+	})
+}
+
+// Describe reads the resource and returns its description.
+func (sr *sweepResource) Describe(ctx context.Context) (*describe.Description, error) {
+	var description *describe.Description
+
+	err := sr.withState(ctx, func(ctx context.Context, resource fwresource.Resource, state tfsdk.State) error {
+		// Capture tags set by resources using transparent tagging.
+		ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+		state, err := readResource(ctx, state, resource)
+		if err != nil {
+			return err
+		}
+
+		if state.Raw.IsNull() {
+			return nil
+		}
+
+		description = &describe.Description{}
+
+		// Attributes may not exist or may be null, so errors are ignored.
+		var s *string
+		if state.GetAttribute(ctx, path.Root(names.AttrID), &s); s != nil {
+			description.ID = *s
+		} else {
+			description.ID = sr.id()
+		}
+		s = nil
+		if state.GetAttribute(ctx, path.Root(names.AttrName), &s); s != nil {
+			description.Name = *s
+		}
+
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			var tags map[string]string
+			if state.GetAttribute(ctx, path.Root(k), &tags); len(tags) > 0 {
+				description.Tags = tags
+				break
+			}
+		}
+		if description.Tags == nil {
+			if inContext, ok := tftags.FromContext(ctx); ok {
+				if tags := inContext.TagsOut.UnwrapOrDefault(); len(tags) > 0 {
+					description.Tags = tags.Map()
+				}
+			}
+		}
+
+		for _, k := range describe.CreationTimeAttributes {
+			s = nil
+			if state.GetAttribute(ctx, path.Root(k), &s); s != nil {
+				if t, ok := describe.ParseTime(*s); ok {
+					description.CreatedAt = t
+					break
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return description, err
+}
+
+// id returns the value of the first attribute specified for the resource, typically its identifier.
+func (sr *sweepResource) id() string {
+	if len(sr.attributes) == 0 {
+		return ""
+	}
+
+	switch v := sr.attributes[0].value.(type) {
+	case *string:
+		return aws.ToString(v)
+
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// withState calls f with the configured resource and the resource's state built from the specified attributes.
+func (sr *sweepResource) withState(ctx context.Context, f func(context.Context, fwresource.Resource, tfsdk.State) error) error {
 	resource, err := sr.factory(ctx)
 	if err != nil {
 		return err
@@ -82,9 +171,7 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 		}
 	}
 
-	tflog.Info(ctx, "Sweeping resource")
-
-	err = deleteResource(ctx, state, resource)
+	err = f(ctx, resource, state)
 
 	if errs.Contains(err, "Value Conversion Error") {
 		// Hack for per-resource Region override.
@@ -105,7 +192,7 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 			}
 		}
 
-		err = deleteResource(ctx, state, resource)
+		err = f(ctx, resource, state)
 	}
 
 	return err
@@ -117,3 +204,12 @@ func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.
 
 	return fwdiag.DiagnosticsError(response.Diagnostics)
 }
+
+func readResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) (tfsdk.State, error) {
+	response := fwresource.ReadResponse{
+		State: state,
+	}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	return response.State, fwdiag.DiagnosticsError(response.Diagnostics)
+}
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// Sweeper is a sweeper function and the names of the sweepers that must run before it.
+type Sweeper struct {
+	Name         string
+	Dependencies []string
+	F            func(region string) error
+}
+
+// Options configures how a Plan is run.
+type Options struct {
+	// AllowFailures continues running sweepers after a sweeper fails.
+	// Sweepers that depend on a failed sweeper are still run.
+	AllowFailures bool
+
+	// Parallelism is the maximum number of sweepers run concurrently.
+	Parallelism int
+}
+
+// Plan is the order in which a set of sweepers is run.
+type Plan struct {
+	graph    *depgraph.Graph
+	stages   [][]string
+	sweepers map[string]*Sweeper
+}
+
+// NewPlan returns the Plan for the sweepers whose names contain any of the comma-separated
+// filter values, case insensitively, and the sweepers they transitively depend on.
+// An empty filter selects all sweepers.
+// An error is returned if a sweeper depends on an unknown sweeper or if there is a dependency cycle.
+func NewPlan(sweepers map[string]*Sweeper, filter string) (*Plan, error) {
+	selected := make(map[string]*Sweeper)
+	var errs []error
+	var add func(string)
+	add = func(name string) {
+		if _, ok := selected[name]; ok {
+			return
+		}
+
+		sweeper := sweepers[name]
+		selected[name] = sweeper
+
+		for _, dependency := range sweeper.Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				errs = append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+				continue
+			}
+
+			add(dependency)
+		}
+	}
+
+	filterValues := strings.Split(strings.ToLower(filter), ",")
+	for _, name := range slices.Sorted(maps.Keys(sweepers)) {
+		if filter == "" || slices.ContainsFunc(filterValues, func(v string) bool {
+			return strings.Contains(strings.ToLower(name), v)
+		}) {
+			add(name)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	g := depgraph.New()
+	names := slices.Sorted(maps.Keys(selected))
+	for _, name := range names {
+		g.AddNode(name)
+	}
+	for _, name := range names {
+		for _, dependency := range selected[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	// A sweeper's stage is one after the latest stage of its dependencies.
+	stageOf := make(map[string]int, len(order))
+	var stages [][]string
+	for _, name := range order {
+		var stage int
+		for _, dependency := range selected[name].Dependencies {
+			stage = max(stage, stageOf[dependency]+1)
+		}
+		stageOf[name] = stage
+
+		if stage == len(stages) {
+			stages = append(stages, nil)
+		}
+		stages[stage] = append(stages[stage], name)
+	}
+	for _, stage := range stages {
+		slices.Sort(stage)
+	}
+
+	return &Plan{
+		graph:    g,
+		stages:   stages,
+		sweepers: selected,
+	}, nil
+}
+
+// Stages returns the sweepers in the order they are run. The sweepers in a stage
+// depend only on sweepers in earlier stages and so may run concurrently.
+func (p *Plan) Stages() [][]string {
+	return p.stages
+}
+
+// String returns a human-readable representation of the Plan.
+func (p *Plan) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Sweeper plan: %d sweepers in %d stages\n", len(p.sweepers), len(p.stages))
+	for i, stage := range p.stages {
+		fmt.Fprintf(&sb, "Stage %d:\n", i+1)
+		for _, name := range stage {
+			if dependencies := p.sweepers[name].Dependencies; len(dependencies) > 0 {
+				fmt.Fprintf(&sb, "\t- %s (after %s)\n", name, strings.Join(slices.Sorted(slices.Values(dependencies)), ", "))
+			} else {
+				fmt.Fprintf(&sb, "\t- %s\n", name)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+type result struct {
+	name string
+	err  error
+}
+
+// Run runs the Plan's sweepers in the specified region and returns the result of each sweeper run.
+// A sweeper runs once all the sweepers it depends on have run. Independent sweepers run concurrently.
+// A sweeper that fails is retried once, after all other runnable sweepers have finished;
+// the sweepers that depend on it wait for the retry.
+func (p *Plan) Run(ctx context.Context, region string, opts Options) map[string]error {
+	parallelism := max(opts.Parallelism, 1)
+
+	waiting := make(map[string]int, len(p.sweepers))
+	var ready, retry []string
+	for name := range p.sweepers {
+		dependencies, _ := p.graph.DirectDependenciesOf(name)
+		waiting[name] = len(dependencies)
+		if waiting[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	results := make(map[string]error, len(p.sweepers))
+	retried := make(map[string]bool)
+	done := make(chan result)
+	var running int
+	var stopped bool
+
+	for {
+		if ctx.Err() != nil {
+			stopped = true
+		}
+
+		for !stopped && len(ready) > 0 && running < parallelism {
+			slices.Sort(ready)
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func(sweeper *Sweeper) {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", sweeper.Name, region)
+
+				start := time.Now()
+				err := sweeper.F(region)
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", sweeper.Name, region, time.Since(start))
+
+				done <- result{name: sweeper.Name, err: err}
+			}(p.sweepers[name])
+		}
+
+		if running == 0 {
+			if stopped || len(retry) == 0 {
+				break
+			}
+
+			ready, retry = retry, nil
+			continue
+		}
+
+		r := <-done
+		running--
+
+		if r.err != nil {
+			if !retried[r.name] {
+				log.Printf("[WARN] Sweeper (%s) in region (%s) failed, retrying after other sweepers finish: %s", r.name, region, r.err)
+				retried[r.name] = true
+				retry = append(retry, r.name)
+				continue
+			}
+
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", r.name, region, r.err)
+			results[r.name] = r.err
+
+			if !opts.AllowFailures {
+				stopped = true
+				continue
+			}
+		} else {
+			results[r.name] = nil
+		}
+
+		dependents, _ := p.graph.DirectDependentsOf(r.name)
+		for _, dependent := range dependents {
+			waiting[dependent]--
+			if waiting[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type recorder struct {
+	mutex sync.Mutex
+	runs  []string
+}
+
+func (r *recorder) sweeper(name string, errs []error, dependencies ...string) *Sweeper {
+	return &Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(string) error {
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+
+			r.runs = append(r.runs, name)
+			if len(errs) > 0 {
+				err := errs[0]
+				errs = errs[1:]
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r *recorder) index(name string) int {
+	return slices.Index(r.runs, name)
+}
+
+func TestNewPlan(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+	sweepers := map[string]*Sweeper{
+		"aws_vpc":               r.sweeper("aws_vpc", nil, "aws_subnet", "aws_internet_gateway"),
+		"aws_subnet":            r.sweeper("aws_subnet", nil, "aws_network_interface"),
+		"aws_internet_gateway":  r.sweeper("aws_internet_gateway", nil),
+		"aws_network_interface": r.sweeper("aws_network_interface", nil),
+		"aws_kms_key":           r.sweeper("aws_kms_key", nil, "aws_db_instance"),
+		"aws_db_instance":       r.sweeper("aws_db_instance", nil),
+	}
+
+	testCases := map[string]struct {
+		filter         string
+		expectedStages [][]string
+	}{
+		"all": {
+			expectedStages: [][]string{
+				{"aws_db_instance", "aws_internet_gateway", "aws_network_interface"},
+				{"aws_kms_key", "aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"filter with dependencies": {
+			filter: "AWS_SUBNET",
+			expectedStages: [][]string{
+				{"aws_network_interface"},
+				{"aws_subnet"},
+			},
+		},
+		"multiple filters": {
+			filter: "kms,gateway",
+			expectedStages: [][]string{
+				{"aws_db_instance", "aws_internet_gateway"},
+				{"aws_kms_key"},
+			},
+		},
+		"no match": {
+			filter: "aws_s3_bucket",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlan(sweepers, testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(plan.Stages(), testCase.expectedStages); diff != "" {
+				t.Errorf("unexpected stages difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPlanErrors(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+
+	testCases := map[string]struct {
+		sweepers      map[string]*Sweeper
+		expectedError string
+	}{
+		"cycle": {
+			sweepers: map[string]*Sweeper{
+				"a": r.sweeper("a", nil, "b"),
+				"b": r.sweeper("b", nil, "c"),
+				"c": r.sweeper("c", nil, "a"),
+			},
+			expectedError: "dependency cycle: a -> b -> c -> a",
+		},
+		"self dependency": {
+			sweepers: map[string]*Sweeper{
+				"a": r.sweeper("a", nil, "a"),
+			},
+			expectedError: "dependency cycle: a -> a",
+		},
+		"unknown dependency": {
+			sweepers: map[string]*Sweeper{
+				"a": r.sweeper("a", nil, "b"),
+			},
+			expectedError: "sweeper (a) has dependency (b), but that sweeper was not found",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewPlan(testCase.sweepers, "")
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, expected := err.Error(), testCase.expectedError; got != expected {
+				t.Errorf("unexpected error. Expected: %q, got: %q", expected, got)
+			}
+		})
+	}
+}
+
+func TestPlanString(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+	plan, err := NewPlan(map[string]*Sweeper{
+		"a": r.sweeper("a", nil, "c", "b"),
+		"b": r.sweeper("b", nil),
+		"c": r.sweeper("c", nil),
+	}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `Sweeper plan: 3 sweepers in 2 stages
+Stage 1:
+	- b
+	- c
+Stage 2:
+	- a (after b, c)
+`
+	if diff := cmp.Diff(plan.String(), expected); diff != "" {
+		t.Errorf("unexpected plan difference: %s", diff)
+	}
+}
+
+func TestPlanRun(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	testCases := map[string]struct {
+		sweepers        func(*recorder) map[string]*Sweeper
+		options         Options
+		expectedResults map[string]error
+		expectedRuns    int
+		expectedOrder   [][2]string
+	}{
+		"dependency order": {
+			sweepers: func(r *recorder) map[string]*Sweeper {
+				return map[string]*Sweeper{
+					"vpc":    r.sweeper("vpc", nil, "subnet", "igw"),
+					"subnet": r.sweeper("subnet", nil, "eni"),
+					"igw":    r.sweeper("igw", nil),
+					"eni":    r.sweeper("eni", nil),
+				}
+			},
+			options: Options{Parallelism: 4},
+			expectedResults: map[string]error{
+				"vpc":    nil,
+				"subnet": nil,
+				"igw":    nil,
+				"eni":    nil,
+			},
+			expectedRuns:  4,
+			expectedOrder: [][2]string{{"eni", "subnet"}, {"subnet", "vpc"}, {"igw", "vpc"}},
+		},
+		"retry succeeds": {
+			sweepers: func(r *recorder) map[string]*Sweeper {
+				return map[string]*Sweeper{
+					"vpc":    r.sweeper("vpc", nil, "subnet"),
+					"subnet": r.sweeper("subnet", []error{errFailed}),
+					"other":  r.sweeper("other", nil),
+				}
+			},
+			options: Options{Parallelism: 1},
+			expectedResults: map[string]error{
+				"vpc":    nil,
+				"subnet": nil,
+				"other":  nil,
+			},
+			expectedRuns:  4,
+			expectedOrder: [][2]string{{"other", "subnet"}, {"subnet", "vpc"}},
+		},
+		"retry fails": {
+			sweepers: func(r *recorder) map[string]*Sweeper {
+				return map[string]*Sweeper{
+					"vpc":    r.sweeper("vpc", nil, "subnet"),
+					"subnet": r.sweeper("subnet", []error{errFailed, errFailed}),
+				}
+			},
+			options: Options{Parallelism: 2},
+			expectedResults: map[string]error{
+				"subnet": errFailed,
+			},
+			expectedRuns: 2,
+		},
+		"retry fails allow failures": {
+			sweepers: func(r *recorder) map[string]*Sweeper {
+				return map[string]*Sweeper{
+					"vpc":    r.sweeper("vpc", nil, "subnet"),
+					"subnet": r.sweeper("subnet", []error{errFailed, errFailed}),
+				}
+			},
+			options: Options{AllowFailures: true, Parallelism: 2},
+			expectedResults: map[string]error{
+				"vpc":    nil,
+				"subnet": errFailed,
+			},
+			expectedRuns:  3,
+			expectedOrder: [][2]string{{"subnet", "vpc"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var r recorder
+			plan, err := NewPlan(testCase.sweepers(&r), "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			results := plan.Run(t.Context(), "us-west-2", testCase.options)
+
+			if diff := cmp.Diff(results, testCase.expectedResults, cmp.Comparer(func(x, y error) bool {
+				return errors.Is(x, y)
+			})); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+
+			if got, expected := len(r.runs), testCase.expectedRuns; got != expected {
+				t.Errorf("incorrect number of runs. Expected: %d, got: %d (%s)", expected, got, strings.Join(r.runs, ", "))
+			}
+
+			for _, v := range testCase.expectedOrder {
+				if before, after := r.index(v[0]), r.index(v[1]); before < 0 || after < 0 || before > after {
+					t.Errorf("expected %s to run before %s, got: %s", v[0], v[1], strings.Join(r.runs, ", "))
+				}
+			}
+		})
+	}
+}
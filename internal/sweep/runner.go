@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/describe"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/runner"
+)
+
+// sweepers contains all registered sweepers.
+var sweepers = make(map[string]*runner.Sweeper)
+
+// AddTestSweepers registers a sweeper. Sweeper names must be unique.
+// The sweeper's Dependencies are the names of the sweepers that must run before it.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = &runner.Sweeper{
+		Name:         name,
+		Dependencies: s.Dependencies,
+		F: func(region string) error {
+			setCurrentSweeper(name, region)
+
+			return s.F(region)
+		},
+	}
+}
+
+// RunOptions configures a sweeper run.
+type RunOptions struct {
+	// AllowFailures continues running sweepers after a sweeper fails.
+	AllowFailures bool
+
+	// DryRun describes the resources that would be deleted instead of deleting them.
+	// The AWS API clients used by sweepers fail any operation that may modify resources.
+	DryRun bool
+
+	// Filter selects the resources that are deleted, or described in dry-run mode.
+	Filter describe.Filter
+
+	// Parallelism is the maximum number of sweepers run concurrently. Dry runs are not concurrent.
+	Parallelism int
+
+	// Report receives the dry-run report as JSON.
+	Report io.Writer
+}
+
+// RunSweepers runs the registered sweepers whose names contain any of the comma-separated filter values,
+// and the sweepers they depend on, in each of the specified regions.
+//
+// The sweepers' dependencies form a graph which is checked for cycles and printed before any sweeper runs.
+// Sweepers run once the sweepers they depend on have run, with up to parallelism independent sweepers running concurrently.
+// A sweeper that fails is retried once after the other runnable sweepers have finished.
+//
+// In dry-run mode no resources are deleted. Each resource that would be deleted is written to the report instead.
+func RunSweepers(ctx context.Context, regions []string, filter string, opts RunOptions) error {
+	plan, err := runner.NewPlan(sweepers, filter)
+	if err != nil {
+		return fmt.Errorf("planning sweepers: %w", err)
+	}
+
+	log.Print(plan)
+
+	allowFailures, parallelism := opts.AllowFailures, opts.Parallelism
+	if opts.DryRun {
+		log.Print("[INFO] Running Sweepers in dry-run mode, no resources will be deleted")
+		allowFailures, parallelism = true, 1
+	}
+
+	configureRun(opts.DryRun, opts.Filter)
+
+	var sweeperErrorFound bool
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		results := plan.Run(ctx, region, runner.Options{
+			AllowFailures: allowFailures,
+			Parallelism:   parallelism,
+		})
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		var failed []string
+		log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+		for _, name := range slices.Sorted(maps.Keys(results)) {
+			if results[name] == nil {
+				log.Printf("\t- %s\n", name)
+			} else {
+				failed = append(failed, name)
+			}
+		}
+
+		if len(failed) > 0 {
+			sweeperErrorFound = true
+			log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+			for _, name := range failed {
+				log.Printf("\t- %s: %s\n", name, results[name])
+
+				if opts.DryRun {
+					addReportEntry(ReportEntry{Type: name, Region: region, Error: results[name].Error()})
+				}
+			}
+
+			if !allowFailures {
+				return fmt.Errorf("sweeper (%s) for region (%s) failed: %w", failed[0], region, results[failed[0]])
+			}
+		}
+	}
+
+	if opts.DryRun {
+		w := opts.Report
+		if w == nil {
+			w = os.Stdout
+		}
+
+		if err := writeReport(w); err != nil {
+			return fmt.Errorf("writing sweeper dry-run report: %w", err)
+		}
+	}
+
+	if sweeperErrorFound {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
@@ -11,7 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/describe"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +43,65 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Describe reads the resource and returns its description.
+func (sr *sweepResource) Describe(ctx context.Context) (*describe.Description, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+	// Capture tags set by resources using transparent tagging.
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil
+	}
+
+	schema := sr.resource.SchemaMap()
+	description := &describe.Description{
+		ID: sr.d.Id(),
+	}
+
+	if _, ok := schema[names.AttrName]; ok {
+		if v, ok := sr.d.Get(names.AttrName).(string); ok {
+			description.Name = v
+		}
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(map[string]any); ok && len(v) > 0 {
+			description.Tags = flex.ExpandStringValueMap(v)
+			break
+		}
+	}
+	if description.Tags == nil {
+		if inContext, ok := tftags.FromContext(ctx); ok {
+			if tags := inContext.TagsOut.UnwrapOrDefault(); len(tags) > 0 {
+				description.Tags = tags.Map()
+			}
+		}
+	}
+
+	for _, k := range describe.CreationTimeAttributes {
+		if _, ok := schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(string); ok {
+			if t, ok := describe.ParseTime(v); ok {
+				description.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return description, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
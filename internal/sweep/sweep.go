@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -29,10 +30,17 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// Sweepers run concurrently, so access is guarded by sweeperClientsMutex.
+var (
+	sweeperClients      map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsMutex sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -63,6 +71,10 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	if isDryRun() {
+		conf.APIOptions = append(conf.APIOptions, readOnlyAPIOption)
+	}
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -109,6 +121,14 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if isDryRun() || !runFilter().IsEmpty() {
+		var err error
+		sweepables, err = selectSweepables(ctx, sweepables)
+		if err != nil {
+			return err
+		}
+	}
+
 	var g tfsync.Group
 
 	for _, sweepable := range sweepables {
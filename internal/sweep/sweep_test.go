@@ -5,10 +5,26 @@ package sweep_test
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/describe"
+)
+
+var (
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of Sweepers to run concurrently")
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources Sweepers would delete without deleting them")
+	flagSweepReport      = flag.String("sweep-report", "", "File to write the dry-run report to, defaults to stdout")
+	flagSweepTag         = flag.String("sweep-tag", "", "Comma-separated list of key or key=value tags a resource must have to be swept")
+	flagSweepNamePrefix  = flag.String("sweep-name-prefix", "", "Comma-separated list of prefixes, one of which a resource's name must start with to be swept")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Minimum age of a resource to be swept, e.g. 2h")
 )
 
 func TestMain(m *testing.M) {
@@ -18,5 +34,69 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the plugin testing framework.
+	// Sweepers are run here, in dependency order, rather than by resource.TestMain.
+	flag.Parse()
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		filter := flag.Lookup("sweep-run").Value.String()
+		if err := runSweepers(ctx, strings.Split(regions, ","), filter); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
+
+func sweepOptions() (sweep.RunOptions, error) {
+	opts := sweep.RunOptions{
+		AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+		DryRun:        *flagSweepDryRun,
+		Parallelism:   *flagSweepParallelism,
+	}
+
+	tags, err := describe.ParseTagFilter(*flagSweepTag)
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Filter = describe.Filter{
+		MinAge: *flagSweepMinAge,
+		Tags:   tags,
+	}
+	if *flagSweepNamePrefix != "" {
+		opts.Filter.NamePrefixes = strings.Split(*flagSweepNamePrefix, ",")
+	}
+
+	return opts, nil
+}
+
+// runSweepers runs the sweepers, writing the dry-run report to the -sweep-report file if one is specified.
+func runSweepers(ctx context.Context, regions []string, filter string) (err error) {
+	opts, err := sweepOptions()
+	if err != nil {
+		return err
+	}
+
+	if *flagSweepReport != "" {
+		if !opts.DryRun {
+			log.Printf("[WARN] -sweep-report is ignored without -sweep-dry-run")
+		} else {
+			f, err := os.Create(*flagSweepReport)
+			if err != nil {
+				return fmt.Errorf("creating sweeper dry-run report: %w", err)
+			}
+			defer func() {
+				if closeErr := f.Close(); closeErr != nil {
+					err = errors.Join(err, fmt.Errorf("closing sweeper dry-run report: %w", closeErr))
+				}
+			}()
+
+			opts.Report = f
+		}
+	}
+
+	return sweep.RunSweepers(ctx, regions, filter, opts)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"path"
+	"slices"
+)
+
+// DefaultTagsRule contains tags to default across the resources matching the rule.
+//
+// Patterns are matched using path.Match syntax, e.g. "aws_s3_*".
+// Resource type patterns are matched against Terraform resource type names and
+// service patterns against provider service package names, e.g. "ec2".
+type DefaultTagsRule struct {
+	Tags KeyValueTags
+
+	// If non-empty, the rule only applies to resources matching at least one pattern.
+	IncludeResourceTypes []string
+	IncludeServices      []string
+
+	// The rule does not apply to resources matching any pattern.
+	ExcludeResourceTypes []string
+	ExcludeServices      []string
+}
+
+// Matches returns whether the rule applies to the specified resource type.
+func (r DefaultTagsRule) Matches(servicePackageName, typeName string) bool {
+	if len(r.IncludeResourceTypes) > 0 && !matchAny(r.IncludeResourceTypes, typeName) {
+		return false
+	}
+	if len(r.IncludeServices) > 0 && !matchAny(r.IncludeServices, servicePackageName) {
+		return false
+	}
+	if matchAny(r.ExcludeResourceTypes, typeName) || matchAny(r.ExcludeServices, servicePackageName) {
+		return false
+	}
+
+	return true
+}
+
+// ForResource returns the DefaultConfig with its rules evaluated for the specified resource type.
+// Tags from matching rules are merged in order over the DefaultConfig's Tags, so later rules
+// take precedence. If no resource type is specified, rules are not evaluated.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	if typeName != "" {
+		for _, rule := range dc.Rules {
+			if rule.Matches(servicePackageName, typeName) {
+				tags = tags.Merge(rule.Tags)
+			}
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
+// ValidateTagsRulePattern returns an error if the pattern is malformed.
+func ValidateTagsRulePattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+func matchAny(patterns []string, s string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, _ := path.Match(pattern, s)
+		return ok
+	})
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "team",
+		}),
+		Rules: []DefaultTagsRule{
+			{
+				Tags:                 New(ctx, map[string]string{"CostCenter": "100"}),
+				ExcludeResourceTypes: []string{"aws_s3_object", "aws_iam_*"},
+			},
+			{
+				Tags:            New(ctx, map[string]string{"Backup": "daily"}),
+				IncludeServices: []string{"ec2", "rds"},
+			},
+			{
+				Tags:                 New(ctx, map[string]string{"CostCenter": "200"}),
+				IncludeResourceTypes: []string{"aws_db_*"},
+				ExcludeServices:      []string{"docdb"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+		wantNil            bool
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+			wantNil:  true,
+		},
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Owner": "team"}),
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want:               map[string]string{"Owner": "team"},
+		},
+		{
+			name:          "no resource type",
+			defaultConfig: defaultConfig,
+			want:          map[string]string{"Owner": "team"},
+		},
+		{
+			name:               "include service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want:               map[string]string{"Owner": "team", "CostCenter": "100", "Backup": "daily"},
+		},
+		{
+			name:               "exclude resource type",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_object",
+			want:               map[string]string{"Owner": "team"},
+		},
+		{
+			name:               "exclude resource type pattern",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want:               map[string]string{"Owner": "team"},
+		},
+		{
+			name:               "later rule takes precedence",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+			want:               map[string]string{"Owner": "team", "CostCenter": "200", "Backup": "daily"},
+		},
+		{
+			name:               "exclude service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "docdb",
+			typeName:           "aws_db_fake",
+			want:               map[string]string{"Owner": "team", "CostCenter": "100"},
+		},
+		{
+			name: "no matching rules",
+			defaultConfig: &DefaultConfig{
+				Rules: []DefaultTagsRule{
+					{
+						Tags:            New(ctx, map[string]string{"Backup": "daily"}),
+						IncludeServices: []string{"rds"},
+					},
+				},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			wantNil:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.wantNil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got.Tags)
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
+func TestValidateTagsRulePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{pattern: "aws_s3_*"},
+		{pattern: "ec2"},
+		{pattern: "aws_[a-z]*"},
+		{pattern: "aws_[", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateTagsRulePattern(testCase.pattern)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ValidateTagsRulePattern(%q) error = %v, wantErr %t", testCase.pattern, err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"regexp"
+	"slices"
+)
+
+// IgnoreTagsRule contains options for removing the tags of the resources matching the rule.
+//
+// Resource type patterns are matched against Terraform resource type names using
+// path.Match syntax, e.g. "aws_s3_*".
+type IgnoreTagsRule struct {
+	Keys          KeyValueTags
+	KeyPrefixes   KeyValueTags
+	ValuePatterns []*regexp.Regexp
+
+	// If non-empty, the rule only applies to resources matching at least one pattern.
+	IncludeResourceTypes []string
+
+	// The rule does not apply to resources matching any pattern.
+	ExcludeResourceTypes []string
+}
+
+// Matches returns whether the rule applies to the specified resource type.
+func (r IgnoreTagsRule) Matches(typeName string) bool {
+	if len(r.IncludeResourceTypes) > 0 && !matchAny(r.IncludeResourceTypes, typeName) {
+		return false
+	}
+	if matchAny(r.ExcludeResourceTypes, typeName) {
+		return false
+	}
+
+	return true
+}
+
+// ForResource returns the IgnoreConfig with its rules evaluated for the specified resource type.
+// The options of all matching rules are added to the IgnoreConfig's options.
+// If no resource type is specified, rules are not evaluated.
+func (ic *IgnoreConfig) ForResource(typeName string) *IgnoreConfig {
+	if ic == nil || len(ic.Rules) == 0 {
+		return ic
+	}
+
+	result := &IgnoreConfig{
+		Keys:          ic.Keys,
+		KeyPrefixes:   ic.KeyPrefixes,
+		ValuePatterns: ic.ValuePatterns,
+	}
+	if typeName != "" {
+		for _, rule := range ic.Rules {
+			if !rule.Matches(typeName) {
+				continue
+			}
+
+			if len(rule.Keys) > 0 {
+				result.Keys = result.Keys.Merge(rule.Keys)
+			}
+			if len(rule.KeyPrefixes) > 0 {
+				result.KeyPrefixes = result.KeyPrefixes.Merge(rule.KeyPrefixes)
+			}
+			result.ValuePatterns = slices.Concat(result.ValuePatterns, rule.ValuePatterns)
+		}
+	}
+
+	if len(result.Keys) == 0 && len(result.KeyPrefixes) == 0 && len(result.ValuePatterns) == 0 {
+		return nil
+	}
+
+	return result
+}
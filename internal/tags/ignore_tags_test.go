@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestIgnoreConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ignoreConfig := &IgnoreConfig{
+		Keys: New(ctx, []string{"key1"}),
+		Rules: []IgnoreTagsRule{
+			{
+				KeyPrefixes:          New(ctx, []string{"scanner:"}),
+				IncludeResourceTypes: []string{"aws_instance", "aws_ebs_*"},
+			},
+			{
+				ValuePatterns:        []*regexp.Regexp{regexache.MustCompile(`^auto-`)},
+				ExcludeResourceTypes: []string{"aws_s3_*"},
+			},
+		},
+	}
+	tags := New(ctx, map[string]string{
+		"key1":          "value1",
+		"key2":          "auto-generated",
+		"scanner:state": "clean",
+		"Name":          "example",
+	})
+
+	testCases := []struct {
+		name         string
+		ignoreConfig *IgnoreConfig
+		typeName     string
+		want         map[string]string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+			want: map[string]string{
+				"key1":          "value1",
+				"key2":          "auto-generated",
+				"scanner:state": "clean",
+				"Name":          "example",
+			},
+		},
+		{
+			name:         "no resource type",
+			ignoreConfig: ignoreConfig,
+			want: map[string]string{
+				"key2":          "auto-generated",
+				"scanner:state": "clean",
+				"Name":          "example",
+			},
+		},
+		{
+			name:         "all rules",
+			ignoreConfig: ignoreConfig,
+			typeName:     "aws_ebs_volume",
+			want: map[string]string{
+				"Name": "example",
+			},
+		},
+		{
+			name:         "some rules",
+			ignoreConfig: ignoreConfig,
+			typeName:     "aws_vpc",
+			want: map[string]string{
+				"scanner:state": "clean",
+				"Name":          "example",
+			},
+		},
+		{
+			name:         "excluded",
+			ignoreConfig: ignoreConfig,
+			typeName:     "aws_s3_bucket",
+			want: map[string]string{
+				"key2":          "auto-generated",
+				"scanner:state": "clean",
+				"Name":          "example",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tags.IgnoreConfig(testCase.ignoreConfig.ForResource(testCase.typeName))
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestIgnoreConfigForResource_noMatchingRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ignoreConfig := &IgnoreConfig{
+		Rules: []IgnoreTagsRule{
+			{
+				Keys:                 New(ctx, []string{"key1"}),
+				IncludeResourceTypes: []string{"aws_instance"},
+			},
+		},
+	}
+
+	if got := ignoreConfig.ForResource("aws_vpc"); got != nil {
+		t.Errorf("expected nil, got %#v", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -53,17 +54,32 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path to a local AWS Organizations tag policy
+	// document to use as the effective tag policy
+	//
+	// When set, the effective tag policy is not retrieved from AWS.
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// Rules contains tags to default across a subset of resources.
+	// Use ForResource to evaluate the rules for a resource type.
+	Rules []DefaultTagsRule
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys          KeyValueTags
+	KeyPrefixes   KeyValueTags
+	ValuePatterns []*regexp.Regexp
+
+	// Rules contains options for removing the tags of a subset of resources.
+	// Use ForResource to evaluate the rules for a resource type.
+	Rules []IgnoreTagsRule
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -78,6 +94,12 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// EnforcedTags is a mapping of Terraform resource type names to the tag key
+	// casing and tag value rules enforced by the effective tag policy
+	//
+	// Only populated when the effective tag policy is read from a local file.
+	EnforcedTags map[string][]TagPolicyRule
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -146,6 +168,7 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreValuePatterns(config.ValuePatterns)
 
 	return result
 }
@@ -197,6 +220,30 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreValuePatterns returns tags whose values do not match any of the given regular expressions.
+func (tags KeyValueTags) IgnoreValuePatterns(ignoreValuePatterns []*regexp.Regexp) KeyValueTags {
+	if len(ignoreValuePatterns) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		value := ""
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		if !slices.ContainsFunc(ignoreValuePatterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(value)
+		}) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -5,9 +5,11 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"slices"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -565,6 +567,42 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "value patterns some matching",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "scan-2024-01-01",
+				"key3": "",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValuePatterns: []*regexp.Regexp{
+					regexache.MustCompile(`^scan-\d{4}-\d{2}-\d{2}$`),
+					regexache.MustCompile(`^$`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys and value patterns",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				ValuePatterns: []*regexp.Regexp{
+					regexache.MustCompile(`2$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagPolicyRule contains the rules an organizational tag policy enforces for a single tag.
+type TagPolicyRule struct {
+	// Key is the tag key with the casing required by the tag policy.
+	Key string
+
+	// Values is the list of allowed tag values. A value may contain "*"
+	// wildcards that match any sequence of characters. An empty list allows
+	// any value.
+	Values []string
+}
+
+// Noncompliant returns a description of the way in which tags do not comply with the rule.
+// An empty string is returned if the tags comply with the rule or do not contain the rule's tag.
+func (r TagPolicyRule) Noncompliant(tags KeyValueTags) string {
+	for k, v := range tags {
+		if !strings.EqualFold(k, r.Key) {
+			continue
+		}
+
+		if k != r.Key {
+			return fmt.Sprintf("tag key %q must use the casing %q", k, r.Key)
+		}
+
+		if len(r.Values) == 0 {
+			return ""
+		}
+
+		value := ""
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+		if !slices.ContainsFunc(r.Values, func(pattern string) bool {
+			return wildcardMatch(pattern, value)
+		}) {
+			return fmt.Sprintf("tag %q value %q is not one of the allowed values %q", k, value, r.Values)
+		}
+
+		return ""
+	}
+
+	return ""
+}
+
+// NoncompliantTags returns a description of each tag that does not comply with the
+// rules enforced for the specified Terraform resource type.
+func (c *TagPolicyConfig) NoncompliantTags(typeName string, tags KeyValueTags) []string {
+	if c == nil {
+		return nil
+	}
+
+	var noncompliant []string
+	for _, rule := range c.EnforcedTags[typeName] {
+		if v := rule.Noncompliant(tags); v != "" {
+			noncompliant = append(noncompliant, v)
+		}
+	}
+	slices.Sort(noncompliant)
+
+	return noncompliant
+}
+
+// wildcardMatch reports whether s matches pattern, where "*" in pattern matches any
+// sequence of characters (including none).
+func wildcardMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
+}
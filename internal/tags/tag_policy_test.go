@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyConfigNoncompliantTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &TagPolicyConfig{
+		EnforcedTags: map[string][]TagPolicyRule{
+			"aws_instance": {
+				{Key: "CostCenter", Values: []string{"100", "200", "3*"}},
+				{Key: "Owner"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		config   *TagPolicyConfig
+		typeName string
+		tags     map[string]string
+		want     []string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		{
+			name:     "not enforced",
+			config:   config,
+			typeName: "aws_s3_bucket",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		{
+			name:     "no tags",
+			config:   config,
+			typeName: "aws_instance",
+		},
+		{
+			name:     "compliant",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "100", "Owner": "anyone", "Other": "value"},
+		},
+		{
+			name:     "compliant wildcard",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "300"},
+		},
+		{
+			name:     "key casing",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"costcenter": "100", "OWNER": "anyone"},
+			want: []string{
+				`tag key "OWNER" must use the casing "Owner"`,
+				`tag key "costcenter" must use the casing "CostCenter"`,
+			},
+		},
+		{
+			name:     "value not allowed",
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "400"},
+			want: []string{
+				`tag "CostCenter" value "400" is not one of the allowed values ["100" "200" "3*"]`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.NoncompliantTags(testCase.typeName, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{pattern: "", s: "", want: true},
+		{pattern: "", s: "a", want: false},
+		{pattern: "abc", s: "abc", want: true},
+		{pattern: "abc", s: "abcd", want: false},
+		{pattern: "*", s: "", want: true},
+		{pattern: "*", s: "anything", want: true},
+		{pattern: "a*", s: "abc", want: true},
+		{pattern: "a*", s: "bc", want: false},
+		{pattern: "*c", s: "abc", want: true},
+		{pattern: "*c", s: "abd", want: false},
+		{pattern: "a*c", s: "ac", want: true},
+		{pattern: "a*c", s: "abbbc", want: true},
+		{pattern: "a*b*c", s: "axbyc", want: true},
+		{pattern: "a*b*c", s: "axyc", want: false},
+		{pattern: "ab*ba", s: "aba", want: false},
+		{pattern: "prod-*-app", s: "prod-east-app", want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+"/"+testCase.s, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := wildcardMatch(testCase.pattern, testCase.s), testCase.want; got != want {
+				t.Errorf("wildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, want)
+			}
+		})
+	}
+}
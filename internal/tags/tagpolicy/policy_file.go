@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// allSupportedResourceType is the resource type used in a tag policy to
+	// refer to all of a service's resource types, e.g. "ec2:ALL_SUPPORTED".
+	allSupportedResourceType = "ALL_SUPPORTED"
+)
+
+// policyDocument is an AWS Organizations tag policy document.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type policyDocument struct {
+	Tags map[string]policyTag `json:"tags"`
+}
+
+type policyTag struct {
+	EnforcedFor          policyValue[[]string] `json:"enforced_for"`
+	ReportRequiredTagFor policyValue[[]string] `json:"report_required_tag_for"`
+	TagKey               policyValue[string]   `json:"tag_key"`
+	TagValue             policyValue[[]string] `json:"tag_value"`
+}
+
+// policyValue is a tag policy value. In a policy as written a value is wrapped
+// in the "@@assign" inheritance operator (e.g. {"@@assign": "CostCenter"}) while
+// in an effective policy the value appears on its own.
+type policyValue[T any] struct {
+	Value T
+}
+
+func (v *policyValue[T]) UnmarshalJSON(b []byte) error {
+	if !bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		return json.Unmarshal(b, &v.Value)
+	}
+
+	var operators struct {
+		Assign json.RawMessage `json:"@@assign"`
+	}
+	if err := json.Unmarshal(b, &operators); err != nil {
+		return err
+	}
+	if operators.Assign == nil {
+		return nil
+	}
+
+	return json.Unmarshal(operators.Assign, &v.Value)
+}
+
+// GetPolicyFromFile reads the AWS Organizations tag policy document in the specified
+// file and returns the required tags and enforced tag rules per Terraform resource type.
+func GetPolicyFromFile(ctx context.Context, filename string) (map[string]tftags.KeyValueTags, map[string][]tftags.TagPolicyRule, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reqTags, enforcedTags, err := parsePolicy(ctx, b)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing tag policy file (%s): %w", filename, err)
+	}
+
+	return reqTags, enforcedTags, nil
+}
+
+// parsePolicy translates a tag policy document into a map of required tags and a
+// map of enforced tag rules per Terraform resource type.
+func parsePolicy(ctx context.Context, b []byte) (map[string]tftags.KeyValueTags, map[string][]tftags.TagPolicyRule, error) {
+	var doc policyDocument
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	reqTags := make(map[string]tftags.KeyValueTags)
+	enforcedTags := make(map[string][]tftags.TagPolicyRule)
+
+	for name, tag := range doc.Tags {
+		key := tag.TagKey.Value
+		if key == "" {
+			key = name
+		}
+		if !strings.EqualFold(key, name) {
+			return nil, nil, fmt.Errorf("tag %q: tag_key %q does not match the tag name", name, key)
+		}
+
+		for _, tfType := range terraformResourceTypes(tag.ReportRequiredTagFor.Value) {
+			newTags := tftags.New(ctx, []string{key})
+			if v, ok := reqTags[tfType]; ok {
+				reqTags[tfType] = v.Merge(newTags)
+			} else {
+				reqTags[tfType] = newTags
+			}
+		}
+
+		rule := tftags.TagPolicyRule{
+			Key:    key,
+			Values: tag.TagValue.Value,
+		}
+		for _, tfType := range terraformResourceTypes(tag.EnforcedFor.Value) {
+			enforcedTags[tfType] = append(enforcedTags[tfType], rule)
+		}
+	}
+
+	return reqTags, enforcedTags, nil
+}
+
+// terraformResourceTypes returns the Terraform resource types corresponding to the
+// specified tag policy resource types, e.g. "ec2:instance" or "ec2:ALL_SUPPORTED".
+func terraformResourceTypes(resourceTypes []string) []string {
+	var tfTypes []string
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupportedResourceType); ok {
+			for k, v := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, v...)
+				}
+			}
+			continue
+		}
+
+		tfTypes = append(tfTypes, Lookup[resourceType]...)
+	}
+
+	slices.Sort(tfTypes)
+	return slices.Compact(tfTypes)
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name             string
+		policy           string
+		wantRequiredTags map[string][]string
+		wantEnforcedTags map[string][]tftags.TagPolicyRule
+		wantErr          bool
+	}{
+		{
+			name:             "empty",
+			policy:           `{}`,
+			wantRequiredTags: map[string][]string{},
+			wantEnforcedTags: map[string][]tftags.TagPolicyRule{},
+		},
+		{
+			name: "policy",
+			policy: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["ec2:instance", "s3:bucket", "unknown:resource"]},
+      "report_required_tag_for": {"@@assign": ["ec2:volume"]}
+    }
+  }
+}`,
+			wantRequiredTags: map[string][]string{
+				"aws_ebs_volume": {"CostCenter"},
+			},
+			wantEnforcedTags: map[string][]tftags.TagPolicyRule{
+				"aws_instance":  {{Key: "CostCenter", Values: []string{"100", "200*"}}},
+				"aws_s3_bucket": {{Key: "CostCenter", Values: []string{"100", "200*"}}},
+			},
+		},
+		{
+			name: "effective policy",
+			policy: `{
+  "tags": {
+    "owner": {
+      "tag_key": "Owner",
+      "report_required_tag_for": ["ec2:instance", "s3:bucket"]
+    },
+    "project": {
+      "tag_key": "Project",
+      "enforced_for": ["s3:bucket"],
+      "report_required_tag_for": ["s3:bucket"]
+    }
+  }
+}`,
+			wantRequiredTags: map[string][]string{
+				"aws_instance":  {"Owner"},
+				"aws_s3_bucket": {"Owner", "Project"},
+			},
+			wantEnforcedTags: map[string][]tftags.TagPolicyRule{
+				"aws_s3_bucket": {{Key: "Project"}},
+			},
+		},
+		{
+			name: "all supported",
+			policy: `{
+  "tags": {
+    "env": {
+      "enforced_for": ["apprunner:ALL_SUPPORTED"]
+    }
+  }
+}`,
+			wantRequiredTags: map[string][]string{},
+			wantEnforcedTags: map[string][]tftags.TagPolicyRule{
+				"aws_apprunner_auto_scaling_configuration_version": {{Key: "env"}},
+				"aws_apprunner_observability_configuration":        {{Key: "env"}},
+				"aws_apprunner_service":                            {{Key: "env"}},
+				"aws_apprunner_vpc_connector":                      {{Key: "env"}},
+				"aws_apprunner_vpc_ingress_connection":             {{Key: "env"}},
+			},
+		},
+		{
+			name: "tag key mismatch",
+			policy: `{
+  "tags": {
+    "owner": {
+      "tag_key": "Team"
+    }
+  }
+}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			policy:  `{"tags": `,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqTags, enforcedTags, err := parsePolicy(ctx, []byte(testCase.policy))
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, wantErr = %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			gotRequiredTags := make(map[string][]string)
+			for k, v := range reqTags {
+				keys := v.Keys()
+				slices.Sort(keys)
+				gotRequiredTags[k] = keys
+			}
+			if diff := cmp.Diff(gotRequiredTags, testCase.wantRequiredTags); diff != "" {
+				t.Errorf("unexpected required tags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(enforcedTags, testCase.wantEnforcedTags); diff != "" {
+				t.Errorf("unexpected enforced tags diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -6,11 +6,17 @@ package vcr
 import (
 	"context"
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
+	"net/http"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 type contextKeyType int
 
-var contextKey contextKeyType
+const (
+	contextKey contextKeyType = iota
+	replayContextKey
+)
 
 // NewContext returns a new context with the provided randomness source stored
 //
@@ -24,3 +30,30 @@ func FromContext(ctx context.Context) (rand.Source, bool) {
 	source, ok := ctx.Value(contextKey).(rand.Source)
 	return source, ok
 }
+
+// NewReplayContext returns a new context indicating whether recorded interactions are being replayed
+func NewReplayContext(ctx context.Context, replaying bool) context.Context {
+	return context.WithValue(ctx, replayContextKey, replaying)
+}
+
+// IsReplaying indicates whether recorded interactions are being replayed
+//
+// If not set in the context, the VCR_MODE environment variable is consulted.
+func IsReplaying(ctx context.Context) bool {
+	if v, ok := ctx.Value(replayContextKey).(bool); ok {
+		return v
+	}
+
+	mode, _ := Mode()
+	return mode == recorder.ModeReplayOnly
+}
+
+// IsReplayingClient indicates whether the HTTP client replays recorded interactions
+func IsReplayingClient(client *http.Client) bool {
+	if client == nil {
+		return false
+	}
+
+	v, ok := client.Transport.(*recorder.Recorder)
+	return ok && !v.IsRecording()
+}
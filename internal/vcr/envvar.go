@@ -4,7 +4,9 @@
 package vcr
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
@@ -14,8 +16,9 @@ const (
 	envVarVCRMode = "VCR_MODE"
 	envVarVCRPath = "VCR_PATH"
 
-	vcrModeRecordOnly = "RECORD_ONLY"
-	vcrModeReplayOnly = "REPLAY_ONLY"
+	vcrModeRecordOnly      = "RECORD_ONLY"
+	vcrModeReplayOnly      = "REPLAY_ONLY"
+	vcrModeRecordIfMissing = "RECORD_IF_MISSING"
 )
 
 // IsEnabled indicates whether VCR testing is enabled
@@ -27,12 +30,18 @@ func IsEnabled() bool {
 }
 
 // Mode returns the VCR recording mode inferred from the VCR_MODE environment variable
+//
+// RECORD_IF_MISSING is returned as recorder.ModeRecordOnce and must be resolved
+// to recorder.ModeRecordOnly or recorder.ModeReplayOnly for each cassette with
+// ResolveMode.
 func Mode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
 	case vcrModeReplayOnly:
 		return recorder.ModeReplayOnly, nil
+	case vcrModeRecordIfMissing:
+		return recorder.ModeRecordOnce, nil
 	default:
 		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
 	}
@@ -42,3 +51,29 @@ func Mode() (recorder.Mode, error) {
 func Path() string {
 	return os.Getenv(envVarVCRPath)
 }
+
+// ResolveMode returns the VCR recording mode to use for a single cassette
+//
+// When the VCR_MODE environment variable is RECORD_IF_MISSING, the cassette is
+// replayed if all of the provided files exist and recorded otherwise. All other
+// modes are returned unchanged.
+func ResolveMode(files ...string) (recorder.Mode, error) {
+	mode, err := Mode()
+	if err != nil {
+		return mode, err
+	}
+
+	if mode != recorder.ModeRecordOnce {
+		return mode, nil
+	}
+
+	for _, file := range files {
+		if _, err := os.Stat(file); errors.Is(err, fs.ErrNotExist) {
+			return recorder.ModeRecordOnly, nil
+		} else if err != nil {
+			return recorder.ModePassthrough, err
+		}
+	}
+
+	return recorder.ModeReplayOnly, nil
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestResolveMode(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.yaml")
+	if err := os.WriteFile(existing, []byte("---"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.yaml")
+
+	testCases := map[string]struct {
+		vcrMode  string
+		files    []string
+		expected recorder.Mode
+	}{
+		"record only": {
+			vcrMode:  "RECORD_ONLY",
+			files:    []string{existing},
+			expected: recorder.ModeRecordOnly,
+		},
+		"replay only": {
+			vcrMode:  "REPLAY_ONLY",
+			files:    []string{missing},
+			expected: recorder.ModeReplayOnly,
+		},
+		"record if missing, exists": {
+			vcrMode:  "RECORD_IF_MISSING",
+			files:    []string{existing},
+			expected: recorder.ModeReplayOnly,
+		},
+		"record if missing, missing": {
+			vcrMode:  "RECORD_IF_MISSING",
+			files:    []string{missing},
+			expected: recorder.ModeRecordOnly,
+		},
+		"record if missing, partially missing": {
+			vcrMode:  "RECORD_IF_MISSING",
+			files:    []string{existing, missing},
+			expected: recorder.ModeRecordOnly,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // t.Setenv is incompatible with t.Parallel
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("VCR_MODE", testCase.vcrMode)
+
+			got, err := vcr.ResolveMode(testCase.files...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestResolveMode_unsupported(t *testing.T) {
+	t.Setenv("VCR_MODE", "RECORD_SOMETIMES")
+
+	if _, err := vcr.ResolveMode(); err == nil {
+		t.Error("expected error, got none")
+	}
+}
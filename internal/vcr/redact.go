@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	// RedactedAccountID is the placeholder substituted for AWS account IDs.
+	RedactedAccountID = "123456789012"
+	// RedactedAccessKeyIDSuffix is the placeholder substituted for the portion
+	// of an AWS access key ID following its AKIA or ASIA prefix.
+	RedactedAccessKeyIDSuffix = "IOSFODNN7EXAMPLE"
+	// RedactedSecretAccessKey is the placeholder substituted for AWS secret access keys.
+	RedactedSecretAccessKey = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+	// RedactedValue is the placeholder substituted for other sensitive values.
+	RedactedValue = "REDACTED"
+)
+
+// RedactionRule rewrites sensitive values in recorded interactions to stable placeholders
+//
+// Replacement may reference capture groups in Pattern, as in regexp.Regexp.ReplaceAllString.
+// Replacements must be idempotent, i.e. applying a rule to an already redacted value
+// must not change it, as live requests are redacted before being matched against
+// recorded interactions.
+type RedactionRule struct {
+	Name        string
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// Apply returns s with all matches of the rule's pattern replaced.
+func (r RedactionRule) Apply(s string) string {
+	return r.Pattern.ReplaceAllString(s, r.Replacement)
+}
+
+var (
+	redactionRulesMu sync.RWMutex
+	redactionRules   = []RedactionRule{
+		{
+			Name:        "arn-account-id",
+			Pattern:     regexache.MustCompile(`(arn(?::|%3A)aws[a-z-]*(?::|%3A)[0-9a-z-]*(?::|%3A)[0-9a-z-]*(?::|%3A))\d{12}`),
+			Replacement: "${1}" + RedactedAccountID,
+		},
+		{
+			Name:        "json-account-id",
+			Pattern:     regexache.MustCompile(`("(?:Account|AccountId|accountId|OwnerId|ownerId)"\s*:\s*")\d{12}"`),
+			Replacement: "${1}" + RedactedAccountID + `"`,
+		},
+		{
+			Name:        "xml-account-id",
+			Pattern:     regexache.MustCompile(`(<(?:Account|AccountId|accountId|OwnerId|ownerId)>)\d{12}(</)`),
+			Replacement: "${1}" + RedactedAccountID + "${2}",
+		},
+		{
+			Name:        "access-key-id",
+			Pattern:     regexache.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+			Replacement: "${1}" + RedactedAccessKeyIDSuffix,
+		},
+		{
+			Name:        "json-secret-access-key",
+			Pattern:     regexache.MustCompile(`("SecretAccessKey"\s*:\s*")[^"]+"`),
+			Replacement: "${1}" + RedactedSecretAccessKey + `"`,
+		},
+		{
+			Name:        "xml-secret-access-key",
+			Pattern:     regexache.MustCompile(`(<SecretAccessKey>)[^<]+(</SecretAccessKey>)`),
+			Replacement: "${1}" + RedactedSecretAccessKey + "${2}",
+		},
+		{
+			Name:        "json-session-token",
+			Pattern:     regexache.MustCompile(`("SessionToken"\s*:\s*")[^"]+"`),
+			Replacement: "${1}" + RedactedValue + `"`,
+		},
+		{
+			Name:        "xml-session-token",
+			Pattern:     regexache.MustCompile(`(<SessionToken>)[^<]+(</SessionToken>)`),
+			Replacement: "${1}" + RedactedValue + "${2}",
+		},
+		{
+			Name:        "presigned-url-signature",
+			Pattern:     regexache.MustCompile(`((?i:X-Amz-Signature)=)[0-9a-f]+`),
+			Replacement: "${1}" + RedactedValue,
+		},
+		{
+			Name:        "presigned-url-security-token",
+			Pattern:     regexache.MustCompile(`((?i:X-Amz-Security-Token)=)[^&"'<\s\\]+`),
+			Replacement: "${1}" + RedactedValue,
+		},
+	}
+)
+
+// RegisterRedactionRule adds a rule to those applied to recorded interactions
+//
+// Rules are applied in the order in which they are registered, after the default rules.
+func RegisterRedactionRule(rule RedactionRule) {
+	redactionRulesMu.Lock()
+	defer redactionRulesMu.Unlock()
+
+	redactionRules = append(redactionRules, rule)
+}
+
+// RedactionRules returns the rules applied to recorded interactions.
+func RedactionRules() []RedactionRule {
+	redactionRulesMu.RLock()
+	defer redactionRulesMu.RUnlock()
+
+	return append([]RedactionRule(nil), redactionRules...)
+}
+
+// Redact returns s with all redaction rules applied.
+func Redact(s string) string {
+	for _, rule := range RedactionRules() {
+		s = rule.Apply(s)
+	}
+	return s
+}